@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kdisneur/changelog/pkg/configuration"
+	sysutils "github.com/kdisneur/changelog/pkg/git/system/utils"
+	"github.com/kdisneur/changelog/pkg/git/utils"
+	"github.com/kdisneur/changelog/pkg/github"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect the configuration",
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check the configuration without calling GitHub",
+	Long:  "Load the configuration files like a changelog generation does, then report the unknown keys, the invalid values and the repository settings that apply",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		report := &checkReport{}
+
+		checkConfiguration(report)
+
+		report.exit()
+	},
+}
+
+type checkReport struct {
+	errors int
+}
+
+func (r *checkReport) ok(format string, args ...interface{}) {
+	fmt.Printf("ok       %s\n", fmt.Sprintf(format, args...))
+}
+
+func (r *checkReport) warning(format string, args ...interface{}) {
+	fmt.Printf("warning  %s\n", fmt.Sprintf(format, args...))
+}
+
+func (r *checkReport) error(format string, args ...interface{}) {
+	r.errors++
+	fmt.Printf("error    %s\n", fmt.Sprintf(format, args...))
+}
+
+func (r *checkReport) exit() {
+	if r.errors > 0 {
+		fmt.Fprintf(os.Stderr, "%d problem(s) found\n", r.errors)
+		os.Exit(1)
+	}
+}
+
+func checkConfiguration(report *checkReport) *configuration.ValidatedConfig {
+	for _, path := range configurationPaths {
+		report.ok("configuration file %s loaded", path)
+	}
+	if len(configurationPaths) == 0 {
+		report.ok("no configuration file, the settings come from the environment, the flags and the defaults")
+	}
+
+	for _, key := range configuration.UnknownKeys(viper.AllSettings()) {
+		report.error("unknown key '%s'", key)
+	}
+
+	problems := configuration.Check(configurationFile)
+	for _, problem := range problems {
+		report.error("%s", problem.Error())
+	}
+
+	checkRepositorySections(report, configurationCommands.RepositoryLocalPath)
+
+	if len(problems) > 0 {
+		return nil
+	}
+
+	conf, err := configuration.Validate(configurationFile, configurationCommands)
+	if err != nil {
+		report.error("%s", err.Error())
+		return nil
+	}
+
+	repositoryName := conf.Remote.RepositoryName
+	if _, ok := configurationFile.FindRepository(repositoryName); ok {
+		report.ok("repository '%s' uses its [[repository]] section", repositoryName)
+	} else if len(configurationFile.Repository) > 0 {
+		report.warning("no [[repository]] section matches '%s', only the [general] settings apply", repositoryName)
+	} else {
+		report.ok("repository '%s' uses the [general] settings", repositoryName)
+	}
+
+	report.ok("commits are read up to '%s'", conf.To)
+
+	return conf
+}
+
+func checkRepositorySections(report *checkReport, repositoryPath string) {
+	if len(configurationFile.Repository) == 0 {
+		return
+	}
+
+	remoteURLs, err := sysutils.RemoteURLs(repositoryPath)
+	if err != nil {
+		report.warning("can't list the git remotes of %s, the [[repository]] names aren't checked", repositoryPath)
+		return
+	}
+
+	remoteNames := utils.RepositoryNamesFromURLs(remoteURLs)
+	for _, repository := range configurationFile.Repository {
+		if !containsFold(remoteNames, repository.Name) {
+			report.warning("[[repository]] '%s' doesn't match any remote of %s (%s)", repository.Name, repositoryPath, strings.Join(remoteNames, ", "))
+		}
+	}
+}
+
+func containsFold(values []string, value string) bool {
+	for _, candidate := range values {
+		if strings.EqualFold(candidate, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func addCheckFlags(command *cobra.Command) {
+	command.Flags().StringVarP(&configurationCommands.RepositoryName, "repository", "r", "", "name of the GitHub repository (e.g. kdisneur/changelog)")
+	command.Flags().StringVarP(&configurationCommands.RepositoryLocalPath, "change-dir", "C", ".", "path to the local repository path (e.g. ~/Workspace/kdisneur/changelog)")
+	command.Flags().StringVarP(&configurationCommands.To, "branch", "b", "", `name of the base branch (default "master")`)
+	command.Flags().StringVarP(&configurationCommands.MergeStrategy, "strategy", "", "", `commit history followed merge strategy (one of "squash", "merge" or a [[parser]] name) (default "squash")`)
+	command.Flags().StringVarP(&configurationCommands.Token, "token", "", "", "GitHub token (default the github.token setting, then $GITHUB_TOKEN)")
+	command.Flags().StringVarP(&configurationCommands.APIURL, "api-url", "", "", fmt.Sprintf("GitHub API URL, for GitHub Enterprise (default %q)", github.DefaultAPIURL))
+}
+
+func init() {
+	addCheckFlags(configValidateCmd)
+
+	configCmd.AddCommand(configValidateCmd)
+	rootCmd.AddCommand(configCmd)
+}
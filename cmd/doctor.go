@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/kdisneur/changelog/pkg/github"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the configuration and the access to GitHub",
+	Long:  "Run the checks of `changelog config validate`, then check the GitHub API is reachable, the token is valid and has the needed scopes, and every [[repository]] exists",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		report := &checkReport{}
+
+		conf := checkConfiguration(report)
+
+		tracker := fallbackTracker()
+		if conf != nil {
+			if configuredTracker, ok := conf.BugTracker.(github.GitHub); ok {
+				tracker = configuredTracker
+			}
+		}
+
+		checkGitHub(report, tracker)
+
+		report.exit()
+	},
+}
+
+func fallbackTracker() github.GitHub {
+	tracker := github.GitHub{Token: configurationCommands.Token, API_URL: configurationCommands.APIURL}
+
+	if tracker.Token == "" {
+		tracker.Token = configurationFile.Github.Token
+	}
+
+	if tracker.API_URL == "" {
+		tracker.API_URL = configurationFile.Github.APIURL
+	}
+
+	if tracker.API_URL == "" {
+		tracker.API_URL = github.DefaultAPIURL
+	}
+
+	return tracker
+}
+
+func checkGitHub(report *checkReport, tracker github.GitHub) {
+	var token *github.TokenInfo
+	if tracker.Token == "" {
+		report.warning("no GitHub token configured, only the public repositories can be read and GitHub limits the calls to 60 per hour")
+	} else {
+		var err error
+
+		token, err = tracker.CheckToken()
+		if err != nil {
+			report.error("%s", err.Error())
+			return
+		}
+
+		report.ok("GitHub API %s reachable, token belongs to '%s'", tracker.API_URL, token.Login)
+	}
+
+	names := []string{}
+	if tracker.Repository != "" {
+		names = append(names, tracker.Repository)
+	}
+	for _, repository := range configurationFile.Repository {
+		if repository.Name != "" && repository.Name != tracker.Repository {
+			names = append(names, repository.Name)
+		}
+	}
+
+	for _, name := range names {
+		repository, err := tracker.FindRepository(name)
+		if err != nil {
+			report.error("%s", err.Error())
+			continue
+		}
+
+		if repository == nil {
+			report.error("repository '%s' doesn't match any GitHub repository the token can read", name)
+			continue
+		}
+
+		report.ok("repository '%s' found on GitHub", name)
+
+		if repository.Private && token != nil && token.HasScopes && !token.HasScope("repo") {
+			report.error("token lacks the 'repo' scope needed to read the private repository '%s'", name)
+		}
+	}
+
+	if token != nil && token.HasScopes && !token.HasScope("repo") && !token.HasScope("public_repo") {
+		report.warning("token lacks the 'public_repo' scope, `changelog publish` can't create releases")
+	}
+}
+
+func init() {
+	addCheckFlags(doctorCmd)
+
+	rootCmd.AddCommand(doctorCmd)
+}
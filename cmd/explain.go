@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/kdisneur/changelog/pkg/changelog"
+)
+
+type explainedCommit struct {
+	Commit     string               `json:"commit"`
+	Subject    string               `json:"subject"`
+	Kept       bool                 `json:"kept"`
+	References []explainedReference `json:"references"`
+	Section    string               `json:"section"`
+}
+
+type explainedReference struct {
+	Type   string `json:"type"`
+	ID     string `json:"id"`
+	Lookup string `json:"lookup"`
+}
+
+func printExplanations(output io.Writer, format string, explanations []*changelog.Explanation) error {
+	switch format {
+	case "json":
+		return printJSONExplanations(output, explanations)
+	case "table":
+		return printTableExplanations(output, explanations)
+	}
+
+	return fmt.Errorf("unknown explain format '%s', expected 'table' or 'json'", format)
+}
+
+func printJSONExplanations(output io.Writer, explanations []*changelog.Explanation) error {
+	commits := make([]explainedCommit, 0, len(explanations))
+
+	for _, explanation := range explanations {
+		commit := explainedCommit{
+			Commit:     explanation.Commit.ID,
+			Subject:    explanation.Commit.Message,
+			Kept:       explanation.Kept,
+			References: make([]explainedReference, 0, len(explanation.References)),
+			Section:    explanation.Section,
+		}
+
+		for _, reference := range explanation.References {
+			commit.References = append(commit.References, explainedReference{
+				Type:   reference.Reference.Type,
+				ID:     reference.Reference.ID,
+				Lookup: reference.Lookup,
+			})
+		}
+
+		commits = append(commits, commit)
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(commits)
+}
+
+func printTableExplanations(output io.Writer, explanations []*changelog.Explanation) error {
+	table := tabwriter.NewWriter(output, 0, 4, 2, ' ', 0)
+
+	fmt.Fprintln(table, "COMMIT\tKEPT\tSUBJECT\tREFERENCES\tSECTION")
+	for _, explanation := range explanations {
+		var references []string
+		for _, reference := range explanation.References {
+			references = append(references, fmt.Sprintf("%s %s: %s", reference.Reference.Type, reference.Reference.ID, reference.Lookup))
+		}
+
+		if len(references) == 0 {
+			references = append(references, "-")
+		}
+
+		fmt.Fprintf(
+			table,
+			"%s\t%t\t%s\t%s\t%s\n",
+			explanation.Commit.ShortID(),
+			explanation.Kept,
+			explanation.Commit.Message,
+			strings.Join(references, ", "),
+			explanation.Section,
+		)
+	}
+
+	return table.Flush()
+}
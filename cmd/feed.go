@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kdisneur/changelog/pkg/changelog"
+	"github.com/kdisneur/changelog/pkg/configuration"
+	"github.com/kdisneur/changelog/pkg/formatter"
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+var feedLimit int
+var feedOutput string
+
+var feedCmd = &cobra.Command{
+	Use:   "feed [flags]",
+	Short: "Generate an Atom feed of the releases",
+	Long:  "Generate the changelog between every pair of consecutive tags reachable from the base branch, and render them as an Atom feed with one entry per release",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		configurationCommands.Date = time.Now()
+
+		conf, err := configuration.Validate(configurationFile, configurationCommands)
+		if err != nil {
+			Exit(err.Error())
+		}
+
+		results, err := changelog.BuildHistory(conf, feedLimit)
+		if err != nil {
+			Exit(err.Error())
+		}
+
+		if len(results) == 0 {
+			Exit(fmt.Sprintf("can't build a feed: no tags are reachable from '%s'", conf.To))
+		}
+
+		var releases []*release.Release
+		var failures []*changelog.Failure
+		for _, result := range results {
+			releases = append(releases, result.Renderings[0].Release)
+			failures = append(failures, result.Failures...)
+		}
+
+		feed := formatter.FormatAtomFeed(releases)
+		if feedOutput == "" {
+			fmt.Print(feed)
+		} else {
+			if err := ioutil.WriteFile(feedOutput, []byte(feed), 0644); err != nil {
+				Exit(fmt.Sprintf("can't write the feed to %s: %s", feedOutput, err.Error()))
+			}
+
+			fmt.Fprintf(os.Stderr, "Feed of %d release(s) written to %s\n", len(releases), feedOutput)
+		}
+
+		exitOnFailures(&changelog.Result{Failures: failures})
+	},
+}
+
+func init() {
+	addChangelogFlags(feedCmd)
+
+	feedCmd.Flags().IntVarP(&feedLimit, "limit", "", 20, "number of releases in the feed, the latest ones first (0 for all of them)")
+	feedCmd.Flags().StringVarP(&feedOutput, "output", "o", "", "write the feed to this file instead of the standard output")
+
+	rootCmd.AddCommand(feedCmd)
+}
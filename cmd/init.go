@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kdisneur/changelog/pkg/configuration"
+	"github.com/kdisneur/changelog/pkg/git/system"
+	sysutils "github.com/kdisneur/changelog/pkg/git/system/utils"
+)
+
+var initRepositoryPath string
+var initLocal bool
+var initForce bool
+var initInteractive bool
+
+var initCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Generate a configuration file from the current repository",
+	Long:  "Inspect the current repository (remote, default branch, squash or merge history) and write a commented configuration file, either in ~/.config/changelog.toml or in the repository",
+	Args:  cobra.NoArgs,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		repositoryPath := initRepositoryPath
+		if rootPath, err := sysutils.TopLevelPath(initRepositoryPath); err == nil {
+			repositoryPath = rootPath
+		}
+
+		repository, err := system.NewRepository(repositoryPath)
+		if err != nil {
+			Exit(err.Error())
+		}
+
+		target, err := initTargetPath(repositoryPath)
+		if err != nil {
+			Exit(err.Error())
+		}
+
+		if _, err := os.Stat(target); err == nil && !initForce {
+			Exit(fmt.Sprintf("%s already exists, use --force to overwrite it", target))
+		}
+
+		detection := configuration.Detect(repository)
+
+		var token string
+		if initInteractive {
+			reader := bufio.NewReader(os.Stdin)
+
+			detection.RepositoryName = prompt(reader, "GitHub repository", detection.RepositoryName)
+			detection.BaseBranch = prompt(reader, "Base branch", detection.BaseBranch)
+			detection.MergeStrategy = prompt(reader, "Merge strategy (squash or merge)", detection.MergeStrategy)
+			if !initLocal {
+				token = prompt(reader, "GitHub token", "")
+			}
+		}
+
+		err = os.MkdirAll(filepath.Dir(target), 0755)
+		if err != nil {
+			Exit(err.Error())
+		}
+
+		permissions := os.FileMode(0600)
+		if initLocal {
+			permissions = 0644
+		}
+
+		err = ioutil.WriteFile(target, []byte(configuration.Render(detection, token, initLocal)), permissions)
+		if err != nil {
+			Exit(err.Error())
+		}
+
+		fmt.Printf("Configuration written to %s\n", target)
+	},
+}
+
+func initTargetPath(repositoryPath string) (string, error) {
+	if initLocal {
+		return configuration.LocalFilePath(repositoryPath), nil
+	}
+
+	if overrideConfigPath != "" {
+		return overrideConfigPath, nil
+	}
+
+	defaultPath, err := configuration.DefaultFilePath()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s.%s", defaultPath, configuration.DEFAULT_EXTENSION), nil
+}
+
+func prompt(reader *bufio.Reader, question string, defaultValue string) string {
+	fmt.Fprintf(os.Stderr, "%s [%s]: ", question, defaultValue)
+
+	answer, _ := reader.ReadString('\n')
+	answer = strings.TrimSpace(answer)
+	if answer == "" {
+		return defaultValue
+	}
+
+	return answer
+}
+
+func init() {
+	initCmd.Flags().StringVarP(&initRepositoryPath, "change-dir", "C", ".", "path to the local repository path (e.g. ~/Workspace/kdisneur/changelog)")
+	initCmd.Flags().BoolVarP(&initLocal, "local", "", false, "write a .changelog.toml file at the root of the repository instead of the global configuration")
+	initCmd.Flags().BoolVarP(&initForce, "force", "f", false, "overwrite the configuration file when it already exists")
+	initCmd.Flags().BoolVarP(&initInteractive, "interactive", "i", false, "confirm every detected value and ask for the GitHub token")
+
+	rootCmd.AddCommand(initCmd)
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kdisneur/changelog/pkg/github"
+)
+
+var releaseTag string
+var releaseOptions github.ReleaseOptions
+
+var publishCmd = &cobra.Command{
+	Use:   "publish [flags] <commit-reference> <new-version-name>",
+	Short: "Create or update the GitHub release with the generated changelog",
+	Long:  "Generate the changelog like the root command does, then create the GitHub release of the tag, or update it when it already exists",
+	Args:  changelogArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		conf, result := generateChangelog(args)
+
+		exitOnFailures(result)
+
+		tracker, ok := conf.BugTracker.(github.GitHub)
+		if !ok {
+			Exit("can't publish a release without the GitHub bug tracker")
+		}
+
+		tag := releaseTag
+		if tag == "" {
+			tag = conf.VersionName
+		}
+
+		release, err := tracker.PublishRelease(tag, result.Changelog, releaseOptions)
+		if err != nil {
+			Exit(err.Error())
+		}
+
+		fmt.Println(release.Link)
+	},
+}
+
+func init() {
+	addChangelogFlags(publishCmd)
+
+	publishCmd.Flags().StringVarP(&releaseTag, "tag", "", "", "tag of the release (default <new-version-name>)")
+	publishCmd.Flags().StringVarP(&releaseOptions.Name, "name", "", "", "title of the release (default <tag>)")
+	publishCmd.Flags().StringVarP(&releaseOptions.TargetCommitish, "target", "", "", "branch or commit the tag is created from when it doesn't exist yet (default the repository default branch)")
+	publishCmd.Flags().BoolVarP(&releaseOptions.Draft, "draft", "", false, "save the release as a draft")
+	publishCmd.Flags().BoolVarP(&releaseOptions.Prerelease, "prerelease", "", false, "mark the release as a pre-release")
+
+	rootCmd.AddCommand(publishCmd)
+}
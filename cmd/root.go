@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strings"
 	"time"
@@ -10,41 +11,216 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/kdisneur/changelog/pkg/changelog"
+	"github.com/kdisneur/changelog/pkg/changelogfile"
 	"github.com/kdisneur/changelog/pkg/configuration"
+	"github.com/kdisneur/changelog/pkg/filter"
+	"github.com/kdisneur/changelog/pkg/formatter"
+	sysutils "github.com/kdisneur/changelog/pkg/git/system/utils"
+	"github.com/kdisneur/changelog/pkg/github"
+	"github.com/kdisneur/changelog/pkg/webhook"
 )
 
 var overrideConfigPath string
+var showExcluded bool
+var strict bool
+var inPlace bool
+var postURL string
+var explain string
 var configurationFile configuration.File
+var configurationPaths []string
 var configurationCommands configuration.Command
 
 var rootCmd = &cobra.Command{
-	Use:   "changelog [flags] <commit-reference> <new-version-name>",
+	Use:   "changelog [flags] <commit-reference> <new-version-name>\n  changelog [flags] --unreleased [<commit-reference>]",
 	Short: "Generate a Changelog based on a Git history",
 	Long:  "Read every commit, and fetch the bug tracker (e.g. GitHub pull request) description for every commits in the Git History",
-	Args: func(cmd *cobra.Command, args []string) error {
-		if len(args) == 2 {
-			return nil
-		}
-
-		return fmt.Errorf("please check the arguments. expected 2, received %d\nArguments: %s", len(args), strings.Join(args, ", "))
+	Args:  changelogArgs,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		loadConfigurationFile()
 	},
 	Run: func(cmd *cobra.Command, args []string) {
+		_, result := generateChangelog(args)
+
+		writeRenderings(result.Renderings)
+
+		exitOnFailures(result)
+	},
+}
+
+func changelogArgs(cmd *cobra.Command, args []string) error {
+	if configurationCommands.Unreleased && len(args) <= 1 {
+		return nil
+	}
+
+	if configurationCommands.Unreleased {
+		return fmt.Errorf("please check the arguments. expected at most 1 with --unreleased, received %d\nArguments: %s", len(args), strings.Join(args, ", "))
+	}
+
+	if len(args) == 2 {
+		return nil
+	}
+
+	return fmt.Errorf("please check the arguments. expected 2, received %d\nArguments: %s", len(args), strings.Join(args, ", "))
+}
+
+func generateChangelog(args []string) (*configuration.ValidatedConfig, *changelog.Result) {
+	if len(args) > 0 {
 		configurationCommands.From = args[0]
+	}
+
+	if len(args) > 1 {
 		configurationCommands.VersionName = args[1]
-		configurationCommands.Date = time.Now()
+	}
 
-		conf, err := configuration.Validate(configurationFile, configurationCommands)
-		if err != nil {
-			Exit(err.Error())
+	configurationCommands.Date = time.Now()
+
+	conf, err := configuration.Validate(configurationFile, configurationCommands)
+	if err != nil {
+		Exit(err.Error())
+	}
+
+	if err := checkOutputOptions(conf); err != nil {
+		Exit(err.Error())
+	}
+
+	result, err := changelog.Build(conf)
+	if explain != "" && result != nil {
+		if printErr := printExplanations(os.Stderr, explain, result.Explanations); printErr != nil {
+			Exit(printErr.Error())
 		}
+	}
 
-		formattedChangelog, err := changelog.BuildChangelog(conf)
-		if err != nil {
-			Exit(err.Error())
+	if err != nil {
+		Exit(err.Error())
+	}
+
+	if showExcluded {
+		for _, rendering := range result.Renderings {
+			if rendering.Profile.Name != "" {
+				fmt.Fprintf(os.Stderr, "Profile %s: ", rendering.Profile.Name)
+			}
+
+			printExclusions(rendering.Excluded)
 		}
+	}
 
-		fmt.Println(formattedChangelog)
-	},
+	return conf, result
+}
+
+func checkOutputOptions(conf *configuration.ValidatedConfig) error {
+	outputs := []string{conf.Output}
+	if len(conf.Profiles) > 0 {
+		outputs = nil
+		for _, profile := range conf.Profiles {
+			outputs = append(outputs, profile.Output)
+		}
+	}
+
+	for _, output := range outputs {
+		if inPlace && output == "" {
+			return fmt.Errorf("--in-place needs an output file, set --output or the output of every profile")
+		}
+
+		if postURL != "" && output != "" {
+			return fmt.Errorf("--post can't be combined with an output file, the changelog would be written to %s instead of being posted", output)
+		}
+	}
+
+	return nil
+}
+
+func writeRenderings(renderings []*changelog.Rendering) {
+	for _, rendering := range renderings {
+		if rendering.Profile.Output == "" && postURL != "" {
+			postRendering(rendering)
+			continue
+		}
+
+		if rendering.Profile.Output == "" {
+			fmt.Println(rendering.Changelog)
+			continue
+		}
+
+		content := strings.TrimSuffix(rendering.Changelog, "\n") + "\n"
+		if inPlace {
+			var err error
+
+			content, err = updateInPlace(rendering)
+			if err != nil {
+				Exit(err.Error())
+			}
+		}
+
+		if err := ioutil.WriteFile(rendering.Profile.Output, []byte(content), 0644); err != nil {
+			Exit(fmt.Sprintf("can't write the changelog to %s: %s", rendering.Profile.Output, err.Error()))
+		}
+
+		if rendering.Profile.Name != "" {
+			fmt.Fprintf(os.Stderr, "Changelog of profile '%s' written to %s\n", rendering.Profile.Name, rendering.Profile.Output)
+		} else {
+			fmt.Fprintf(os.Stderr, "Changelog written to %s\n", rendering.Profile.Output)
+		}
+	}
+}
+
+func postRendering(rendering *changelog.Rendering) {
+	format := rendering.Profile.Formatter
+	if !format.Equal(formatter.NewSlackFormatter()) && !format.Equal(formatter.NewTeamsFormatter()) {
+		Exit("can't post the changelog: only the slack and teams formats can be posted to a webhook")
+	}
+
+	if err := webhook.Post(postURL, rendering.Changelog); err != nil {
+		Exit(err.Error())
+	}
+
+	if rendering.Profile.Name != "" {
+		fmt.Fprintf(os.Stderr, "Changelog of profile '%s' posted to the webhook\n", rendering.Profile.Name)
+	} else {
+		fmt.Fprintln(os.Stderr, "Changelog posted to the webhook")
+	}
+}
+
+func updateInPlace(rendering *changelog.Rendering) (string, error) {
+	if !rendering.Profile.Formatter.Equal(formatter.NewMarkdownFormatter()) {
+		return "", fmt.Errorf("can't update %s in place: only the markdown format can be updated", rendering.Profile.Output)
+	}
+
+	existing, err := ioutil.ReadFile(rendering.Profile.Output)
+	if err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("can't read the changelog %s: %s", rendering.Profile.Output, err.Error())
+	}
+
+	return changelogfile.Update(string(existing), rendering.Changelog), nil
+}
+
+func exitOnFailures(result *changelog.Result) {
+	printFailures(result.Failures)
+	if strict && len(result.Failures) > 0 {
+		os.Exit(1)
+	}
+}
+
+func printExclusions(exclusions []*filter.Exclusion) {
+	if len(exclusions) == 0 {
+		fmt.Fprintln(os.Stderr, "No issues excluded")
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "%d issue(s) excluded:\n", len(exclusions))
+	for _, exclusion := range exclusions {
+		fmt.Fprintf(os.Stderr, "- #%s %s: %s\n", exclusion.Issue.ID, exclusion.Issue.Subject, exclusion.Reason)
+	}
+}
+
+func printFailures(failures []*changelog.Failure) {
+	if len(failures) == 0 {
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "%d issue(s) can't be fetched and fell back to their commit:\n", len(failures))
+	for _, failure := range failures {
+		fmt.Fprintf(os.Stderr, "- %s (%s %s): %s\n", failure.Reference.ID, failure.Commit.ShortID(), failure.Commit.Message, failure.Err.Error())
+	}
 }
 
 func Exit(message string) {
@@ -60,43 +236,144 @@ func Execute() {
 }
 
 func init() {
-	cobra.OnInitialize(loadConfigurationFile)
-
 	defaultConfigurationPath, err := configuration.DefaultFilePath()
 	if err != nil {
 		Exit(err.Error())
 	}
 
 	rootCmd.PersistentFlags().StringVar(&overrideConfigPath, "config", "", fmt.Sprintf("config file (default is %s)", defaultConfigurationPath))
-	rootCmd.Flags().StringVarP(&configurationCommands.RepositoryName, "repository", "r", "", "name of the GitHub repository (e.g. kdisneur/changelog)")
-	rootCmd.Flags().StringVarP(&configurationCommands.RepositoryLocalPath, "change-dir", "C", ".", "path to the local repository path (e.g. ~/Workspace/kdisneur/changelog)")
-	rootCmd.Flags().StringVarP(&configurationCommands.To, "branch", "b", "", `name of the base branch (default "master")`)
-	rootCmd.Flags().StringVarP(&configurationCommands.MergeStrategy, "strategy", "", "", `commit history followed merge strategy (one of "squash" or "merge") (default "squash")`)
+	addChangelogFlags(rootCmd)
+	rootCmd.Flags().BoolVarP(&configurationCommands.Unreleased, "unreleased", "", false, "generate the \"## [Unreleased]\" section from the latest tag reachable from the base branch, or from <commit-reference>")
+	rootCmd.Flags().StringVarP(&configurationCommands.Output, "output", "o", "", "write the changelog to this file instead of the standard output")
+	rootCmd.Flags().BoolVarP(&inPlace, "in-place", "", false, "update the section of the same version, or the Unreleased one, in the output files instead of overwriting them (e.g. CHANGELOG.md)")
+	rootCmd.Flags().StringVarP(&postURL, "post", "", "", "post the changelog to this Slack or Teams incoming webhook URL instead of printing it (requires --format slack or teams)")
+}
+
+func addChangelogFlags(command *cobra.Command) {
+	command.Flags().StringVarP(&configurationCommands.RepositoryName, "repository", "r", "", "name of the GitHub repository (e.g. kdisneur/changelog)")
+	command.Flags().StringVarP(&configurationCommands.RepositoryLocalPath, "change-dir", "C", ".", "path to the local repository path (e.g. ~/Workspace/kdisneur/changelog)")
+	command.Flags().StringVarP(&configurationCommands.To, "branch", "b", "", `name of the base branch (default "master")`)
+	command.Flags().StringVarP(&configurationCommands.MergeStrategy, "strategy", "", "", `commit history followed merge strategy (one of "squash", "merge" or a [[parser]] name) (default "squash")`)
+	command.Flags().StringVarP(&configurationCommands.DateSource, "date", "", "", `release date: a YYYY-MM-DD date, "tag" for the date of the <branch> tag or "commit" for the date of its last commit (default today)`)
+	command.Flags().StringVarP(&configurationCommands.Timezone, "timezone", "", "", `timezone the release date is written in (e.g. "Europe/Paris" or "UTC") (default the local one)`)
+	command.Flags().StringVarP(&configurationCommands.Format, "format", "", "", fmt.Sprintf("format of the changelog (one of %s) (default \"markdown\")", strings.Join(formatter.Names(), ", ")))
+	command.Flags().StringSliceVarP(&configurationCommands.Profiles, "profile", "p", nil, "render the changelog of these [profile] sections, sharing the git history and the issues fetched. Can be repeated")
+	command.Flags().StringVarP(&configurationCommands.Token, "token", "", "", "GitHub token (default the github.token setting, then $GITHUB_TOKEN)")
+	command.Flags().StringVarP(&configurationCommands.APIURL, "api-url", "", "", fmt.Sprintf("GitHub API URL, for GitHub Enterprise (default %q)", github.DefaultAPIURL))
+	command.Flags().StringVarP(&configurationCommands.Reverts, "reverts", "", "", `what to do with the changes reverted in the same range (one of "cancel", "list" or "keep") (default "cancel")`)
+	command.Flags().BoolVarP(&configurationCommands.Tolerant, "tolerant", "", false, "fall back to the commit subject when an issue can't be fetched instead of aborting")
+	command.Flags().BoolVarP(&strict, "strict", "", false, "exit with a non-zero code when an issue can't be fetched in tolerant mode")
+	command.Flags().StringSliceVarP(&configurationCommands.ShippedIn, "shipped-in", "", nil, "exclude the changes already shipped in this branch or tag (e.g. release-1.x), cherry-picks included")
+	command.Flags().StringVarP(&explain, "explain", "", "", `print on stderr how every commit was classified, as a "table" or "json"`)
+	command.Flags().Lookup("explain").NoOptDefVal = "table"
+	command.Flags().BoolVarP(&showExcluded, "show-excluded", "", false, "print on stderr the issues dropped by the include/exclude rules and why")
+	command.Flags().BoolVarP(&configurationCommands.ReleaseNotes, "release-notes", "", false, "use the release notes section of the pull request description instead of its title")
+	command.Flags().BoolVarP(&configurationCommands.CompareLinks, "compare-links", "", false, "link the version heading to the comparison with the previous version")
+	command.Flags().BoolVarP(&configurationCommands.LinkedIssues, "linked-issues", "", false, "list the GitHub issues closed by every pull request")
+	command.Flags().BoolVarP(&configurationCommands.DirectCommits, "direct-commits", "", false, "list the commits referencing no pull request in an \"Other changes\" section")
+	command.Flags().BoolVarP(&configurationCommands.Contributors, "contributors", "", false, "append a section listing the contributors of the release")
 }
 
 func loadConfigurationFile() {
-	viper.SetConfigType("toml")
+	file, paths, err := readConfigurationFile(viper.GetViper(), configurationCommands.RepositoryLocalPath)
+	if err != nil {
+		Exit(err.Error())
+	}
+
+	configurationFile = file
+	configurationPaths = paths
+}
+
+func loadRepositoryConfigurationFile(repositoryPath string) (configuration.File, error) {
+	file, _, err := readConfigurationFile(viper.New(), repositoryPath)
+
+	return file, err
+}
+
+func readConfigurationFile(v *viper.Viper, repositoryPath string) (configuration.File, []string, error) {
+	var file configuration.File
+	var paths []string
+
+	v.SetConfigType("toml")
 
 	if overrideConfigPath != "" {
-		viper.SetConfigFile(overrideConfigPath)
+		v.SetConfigFile(overrideConfigPath)
 	} else {
 		defaultConfigurationFolder, err := configuration.DefaultFolderPath()
 		if err != nil {
-			Exit(err.Error())
+			return file, nil, err
 		}
 
-		viper.AddConfigPath(defaultConfigurationFolder)
-		viper.SetConfigName(configuration.DefaultFileName())
+		v.AddConfigPath(defaultConfigurationFolder)
+		v.SetConfigName(configuration.DefaultFileName())
+	}
+
+	v.SetEnvPrefix("changelog")
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	for _, key := range configuration.Keys() {
+		v.BindEnv(key)
+	}
+
+	err := v.ReadInConfig()
+	_, userFileNotFound := err.(viper.ConfigFileNotFoundError)
+	if err != nil && !userFileNotFound {
+		return file, nil, err
 	}
 
-	viper.AutomaticEnv()
+	if !userFileNotFound {
+		paths = append(paths, v.ConfigFileUsed())
+	}
 
-	err := viper.ReadInConfig()
+	localPath, err := mergeLocalConfigurationFile(v, repositoryPath)
 	if err != nil {
-		Exit(err.Error())
+		return file, nil, err
+	}
+
+	if localPath != "" {
+		paths = append(paths, localPath)
+	}
+
+	if err := v.Unmarshal(&file); err != nil {
+		return file, nil, fmt.Errorf("can't parse configuration file: %s", err.Error())
 	}
 
-	if viper.Unmarshal(&configurationFile) != nil {
-		Exit("can't parse configuration file")
+	if file.Github.Token == "" {
+		file.Github.Token = os.Getenv("GITHUB_TOKEN")
 	}
+
+	return file, paths, nil
+}
+
+func mergeLocalConfigurationFile(v *viper.Viper, repositoryPath string) (string, error) {
+	rootPath, err := sysutils.TopLevelPath(repositoryPath)
+	if err != nil {
+		return "", nil
+	}
+
+	localPath := configuration.LocalFilePath(rootPath)
+	if _, err := os.Stat(localPath); os.IsNotExist(err) {
+		return "", nil
+	}
+
+	local := viper.New()
+	local.SetConfigFile(localPath)
+	if err := local.ReadInConfig(); err != nil {
+		return "", fmt.Errorf("can't read configuration file '%s': %s", localPath, err.Error())
+	}
+
+	if problems := configuration.CheckLocalFile(local.AllSettings()); len(problems) > 0 {
+		var messages []string
+		for _, problem := range problems {
+			messages = append(messages, problem.Error())
+		}
+
+		return "", fmt.Errorf("invalid configuration file '%s':\n%s", localPath, strings.Join(messages, "\n"))
+	}
+
+	v.SetConfigFile(localPath)
+	if err := v.MergeInConfig(); err != nil {
+		return "", fmt.Errorf("can't read configuration file '%s': %s", localPath, err.Error())
+	}
+
+	return localPath, nil
 }
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kdisneur/changelog/pkg/server"
+)
+
+var listenAddress string
+var repositoriesPath string
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve the changelogs of the local repositories over HTTP",
+	Long:  "Expose GET /repos/{owner}/{repo}/changelog?from=v1.0.0&to=master&version=v1.1.0&format=json|markdown, reading the repositories cloned in <repositories-dir>/{owner}/{repo}",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Fprintf(os.Stderr, "Listening on %s\n", listenAddress)
+
+		err := http.ListenAndServe(listenAddress, server.New(loadRepositoryConfigurationFile, repositoriesPath))
+		if err != nil {
+			Exit(err.Error())
+		}
+	},
+}
+
+func init() {
+	serveCmd.Flags().StringVarP(&listenAddress, "listen", "l", ":8080", "address the HTTP server listens on")
+	serveCmd.Flags().StringVarP(&repositoriesPath, "repositories-dir", "d", ".", "folder containing the local repositories, cloned as <owner>/<repo>")
+
+	rootCmd.AddCommand(serveCmd)
+}
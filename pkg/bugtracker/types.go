@@ -1,16 +1,57 @@
 package bugtracker
 
+import "fmt"
+
 type BugTracker interface {
 	Equal(other BugTracker) bool
 	FindIssue(id string) (*Issue, error)
 }
 
+type Author struct {
+	Login string
+	Link  string
+}
+
 type Issue struct {
-	ID      string
-	Subject string
-	Link    string
+	ID           string
+	Subject      string
+	Link         string
+	Author       Author
+	Labels       []string
+	Body         string
+	LinkedIssues []LinkedIssue
+}
+
+type LinkedIssue struct {
+	ID         string
+	Repository string
+	Link       string
+}
+
+func (l LinkedIssue) Label() string {
+	return fmt.Sprintf("%s#%s", l.Repository, l.ID)
 }
 
 func (i *Issue) Equal(other *Issue) bool {
-	return i.ID == other.ID && i.Subject == other.Subject && i.Link == other.Link
+	if len(i.Labels) != len(other.Labels) {
+		return false
+	}
+
+	for index, label := range i.Labels {
+		if label != other.Labels[index] {
+			return false
+		}
+	}
+
+	if len(i.LinkedIssues) != len(other.LinkedIssues) {
+		return false
+	}
+
+	for index, linkedIssue := range i.LinkedIssues {
+		if linkedIssue != other.LinkedIssues[index] {
+			return false
+		}
+	}
+
+	return i.ID == other.ID && i.Subject == other.Subject && i.Link == other.Link && i.Author == other.Author && i.Body == other.Body
 }
@@ -36,6 +36,24 @@ func TestIssueEqual(t *testing.T) {
 			bugtracker.Issue{ID: "42", Subject: "A good subject name", Link: "https://anothersite.com/issue/42"},
 			false,
 		},
+		{
+			"When issues have different authors",
+			bugtracker.Issue{ID: "42", Subject: "A good subject name", Link: "https://site.com/issue/42", Author: bugtracker.Author{Login: "johndoe"}},
+			bugtracker.Issue{ID: "42", Subject: "A good subject name", Link: "https://site.com/issue/42", Author: bugtracker.Author{Login: "janedoe"}},
+			false,
+		},
+		{
+			"When issues have different labels",
+			bugtracker.Issue{ID: "42", Subject: "A good subject name", Link: "https://site.com/issue/42", Labels: []string{"bug"}},
+			bugtracker.Issue{ID: "42", Subject: "A good subject name", Link: "https://site.com/issue/42", Labels: []string{"enhancement"}},
+			false,
+		},
+		{
+			"When issues have different bodies",
+			bugtracker.Issue{ID: "42", Subject: "A good subject name", Link: "https://site.com/issue/42", Body: "A description"},
+			bugtracker.Issue{ID: "42", Subject: "A good subject name", Link: "https://site.com/issue/42", Body: "Another description"},
+			false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -2,41 +2,278 @@ package changelog
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/kdisneur/changelog/pkg/bugtracker"
 	"github.com/kdisneur/changelog/pkg/configuration"
+	"github.com/kdisneur/changelog/pkg/filter"
+	"github.com/kdisneur/changelog/pkg/git"
+	"github.com/kdisneur/changelog/pkg/parser"
+	"github.com/kdisneur/changelog/pkg/release"
+	"github.com/kdisneur/changelog/pkg/releasenote"
 )
 
+type Result struct {
+	Changelog    string
+	Excluded     []*filter.Exclusion
+	Failures     []*Failure
+	Explanations []*Explanation
+	Renderings   []*Rendering
+}
+
+type Rendering struct {
+	Profile   *configuration.ValidatedProfile
+	Release   *release.Release
+	Changelog string
+	Excluded  []*filter.Exclusion
+	issues    []*bugtracker.Issue
+}
+
+type Failure struct {
+	Commit    *git.Commit
+	Reference parser.Reference
+	Err       error
+}
+
+type collection struct {
+	issues        []*bugtracker.Issue
+	contributions []contribution
+	failures      []*Failure
+	unreferenced  []*git.Commit
+	references    map[*bugtracker.Issue][]parser.Reference
+}
+
 func BuildChangelog(conf *configuration.ValidatedConfig) (string, error) {
-	commits, err := conf.Repository.Log(conf.From, conf.To)
+	result, err := Build(conf)
 	if err != nil {
 		return "", err
 	}
 
-	if len(commits) == 0 {
-		return "", errors.New("no commits found")
+	return result.Changelog, nil
+}
+
+func Build(conf *configuration.ValidatedConfig) (*Result, error) {
+	return build(conf, conf.Unreleased)
+}
+
+func build(conf *configuration.ValidatedConfig, keepEmpty bool) (*Result, error) {
+	commits, err := conf.Repository.Log(conf.From, conf.To)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(commits) == 0 && !keepEmpty {
+		return nil, errors.New("no commits found")
+	}
+
+	releasePackage := buildPackage(conf, commits)
+	mainline := findMainlineCommits(commits)
+	explained := newExplanations(conf.CommitParser, commits)
+	result := &Result{Explanations: explained.list}
+	profiles := selectedProfiles(conf)
+
+	var revertedCommits []*git.Commit
+	if conf.Reverts != configuration.RevertsKeep {
+		var reverts []*git.Commit
+		commits, revertedCommits, reverts = cancelReverts(commits)
+
+		if conf.Reverts == configuration.RevertsList {
+			explained.setSection(revertedCommits, configuration.SectionReverted)
+		} else {
+			explained.setSection(revertedCommits, "dropped (reverted in the same range)")
+		}
+		explained.setSection(reverts, "dropped (revert of a change in the same range)")
+	}
+
+	collected, err := collectIssues(conf, commits, explained)
+	if err != nil {
+		return nil, err
+	}
+
+	shipped, err := findShippedChanges(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	var directCommits []*release.Commit
+	if needsSection(profiles, configuration.SectionOtherChanges) {
+		directCommits = buildDirectCommits(conf, shipped.excludeCommits(collected.unreferenced), mainline)
+	}
+
+	var revertedIssues []*bugtracker.Issue
+	if conf.Reverts == configuration.RevertsList && len(revertedCommits) > 0 {
+		reverted, err := collectIssues(conf, revertedCommits, explained)
+		if err != nil {
+			return nil, err
+		}
+
+		revertedIssues = reverted.issues
+		for _, commit := range reverted.unreferenced {
+			revertedIssues = append(revertedIssues, fallbackIssue(conf.Remote, commit))
+		}
+		collected.failures = append(collected.failures, reverted.failures...)
+	}
+
+	if len(collected.issues) == 0 && len(directCommits) == 0 && len(revertedIssues) == 0 && !keepEmpty {
+		explained.resolveSections(nil, nil, nil)
+
+		return result, errors.New("no commits kept")
+	}
+
+	walked := &walk{directCommits: directCommits, revertedIssues: revertedIssues, contributions: collected.contributions, releasePackage: releasePackage}
+	walked.issues, walked.excluded = shipped.excludeIssues(collected)
+
+	if needsSection(profiles, configuration.SectionContributors) && conf.From != "" {
+		walked.previousAuthors, err = conf.Repository.Authors(conf.From)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, profile := range profiles {
+		result.Renderings = append(result.Renderings, render(conf, profile, walked))
+	}
+
+	first := result.Renderings[0]
+	if first.Profile.HasSection(configuration.SectionOtherChanges) {
+		explained.resolveSections(first.issues, first.Excluded, directCommits)
+	} else {
+		explained.resolveSections(first.issues, first.Excluded, nil)
 	}
 
-	var issues []*bugtracker.Issue
+	result.Changelog = first.Changelog
+	result.Excluded = first.Excluded
+	result.Failures = collected.failures
+
+	return result, nil
+}
+
+func collectIssues(conf *configuration.ValidatedConfig, commits []*git.Commit, explained *explanations) (*collection, error) {
+	collected := &collection{references: make(map[*bugtracker.Issue][]parser.Reference)}
+	resolvedIssues := make(map[parser.Reference]*bugtracker.Issue)
+	fallbackIssues := make(map[string]*bugtracker.Issue)
 	for _, commit := range commits {
-		if conf.CommitParser.KeepCommit(commit.Message) {
-			id, err := conf.CommitParser.FindID(commit.Message)
-			if err != nil {
-				return "", err
-			}
+		references := findReferences(conf, commit)
+		if len(references) == 0 {
+			collected.unreferenced = append(collected.unreferenced, commit)
+		}
+
+		for _, reference := range references {
+			lookup := "cached"
 
-			issue, err := conf.BugTracker.FindIssue(id)
-			if err != nil {
-				return "", err
+			issue, alreadyResolved := resolvedIssues[reference]
+			if !alreadyResolved {
+				var err error
+
+				issue, err = resolveReference(conf.BugTracker, commit, reference)
+				if err != nil && !conf.Tolerant {
+					return nil, err
+				}
+
+				lookup = describeLookup(reference, err)
+
+				if err != nil {
+					collected.failures = append(collected.failures, &Failure{Commit: commit, Reference: reference, Err: err})
+
+					issue, alreadyResolved = fallbackIssues[commit.ID]
+					if !alreadyResolved {
+						issue = fallbackIssue(conf.Remote, commit)
+						fallbackIssues[commit.ID] = issue
+					}
+				}
+
+				resolvedIssues[reference] = issue
+				collected.references[issue] = append(collected.references[issue], reference)
+				if !alreadyResolved {
+					collected.issues = append(collected.issues, issue)
+				}
 			}
 
-			issues = append(issues, issue)
+			explained.addLookup(commit, reference, lookup, issue)
+			collected.contributions = append(collected.contributions, contribution{commit: commit, issue: issue})
 		}
 	}
 
-	if len(issues) == 0 {
-		return "", errors.New("no commits kept")
+	return collected, nil
+}
+
+func describeLookup(reference parser.Reference, err error) string {
+	if err != nil {
+		return fmt.Sprintf("failed, using the commit instead: %s", err.Error())
+	}
+
+	if reference.Type != parser.PullRequest {
+		return fmt.Sprintf("not fetched (%s reference)", reference.Type)
+	}
+
+	return "fetched"
+}
+
+func resolveReference(tracker bugtracker.BugTracker, commit *git.Commit, reference parser.Reference) (*bugtracker.Issue, error) {
+	if reference.Type == parser.PullRequest {
+		return tracker.FindIssue(reference.ID)
+	}
+
+	return &bugtracker.Issue{
+		ID:      reference.ID,
+		Subject: commit.Message,
+		Link:    reference.Link,
+	}, nil
+}
+
+func fallbackIssue(remote *git.Remote, commit *git.Commit) *bugtracker.Issue {
+	issue := &bugtracker.Issue{
+		ID:      commit.ShortID(),
+		Subject: commit.Message,
+	}
+
+	if remote != nil {
+		issue.Link = remote.CommitURL(commit.ID)
+	}
+
+	return issue
+}
+
+func applyReleaseNotes(extractor *releasenote.Extractor, issues []*bugtracker.Issue) ([]*bugtracker.Issue, []*filter.Exclusion) {
+	var kept []*bugtracker.Issue
+	var excluded []*filter.Exclusion
+
+	for _, issue := range issues {
+		note, found := extractor.Extract(issue.Body)
+
+		if found && releasenote.IsNone(note) {
+			excluded = append(excluded, &filter.Exclusion{Issue: issue, Reason: "release note is NONE"})
+			continue
+		}
+
+		if found {
+			notedIssue := *issue
+			notedIssue.Subject = note
+			issue = &notedIssue
+		}
+
+		kept = append(kept, issue)
+	}
+
+	return kept, excluded
+}
+
+func keptContributions(contributions []contribution, excluded []*filter.Exclusion) []contribution {
+	var kept []contribution
+
+	for _, contribution := range contributions {
+		isExcluded := false
+		for _, exclusion := range excluded {
+			if exclusion.Issue == contribution.issue {
+				isExcluded = true
+				break
+			}
+		}
+
+		if !isExcluded {
+			kept = append(kept, contribution)
+		}
 	}
 
-	return conf.Formatter.FormatIssues(conf.VersionName, conf.Date, issues), nil
+	return kept
 }
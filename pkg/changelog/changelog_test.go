@@ -1,15 +1,20 @@
 package changelog_test
 
 import (
+	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/kdisneur/changelog/pkg/changelog"
 	"github.com/kdisneur/changelog/pkg/configuration"
+	"github.com/kdisneur/changelog/pkg/filter"
 	"github.com/kdisneur/changelog/pkg/formatter"
 	"github.com/kdisneur/changelog/pkg/git"
 	"github.com/kdisneur/changelog/pkg/github"
+	"github.com/kdisneur/changelog/pkg/parser"
+	"github.com/kdisneur/changelog/pkg/releasenote"
 	"github.com/kdisneur/changelog/pkg/testing/bugtracker"
 	"github.com/kdisneur/changelog/pkg/testing/repository"
 )
@@ -72,6 +77,339 @@ func TestBuildChangelog(t *testing.T) {
 
 [#1234]: https://bugtracker.com/issue/1234
 [#1337]: https://bugtracker.com/issue/1337
+`,
+		},
+		{
+			Name: "When contributors are enabled",
+			BuildConfiguration: func() *configuration.ValidatedConfig {
+				tracker := bugtracker.NewBugTracker()
+				repo := repository.New("git@github.com/kdisneur/changelog")
+
+				repo.AddCommit(
+					"7f76fa251d611ed48de62c460ec8f1b00804486b",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 53, 12, 0, time.UTC),
+					"initial Commit",
+				)
+
+				repo.AddCommit(
+					"16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Add feature 1 (#1234)",
+				)
+
+				repo.AddCommit(
+					"854da8029c41f552de16b81f7aba0e407a6bcb1c",
+					git.Person{Fullname: "Jane Doe", Email: "jane.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Add feature 2 (#1337)",
+				)
+
+				repo.AddCommit(
+					"555475c1e0c506eaf23d0db155f6592f7383c495",
+					git.Person{Fullname: "Dependabot", Email: "bot@dependabot.com"},
+					time.Date(2018, time.November, 22, 5, 57, 12, 0, time.UTC),
+					"Bump dependency (#1338)",
+				)
+
+				repo.AddCommit(
+					"6398b4e189b94ce300641431d3dfa00c373d1bb1",
+					git.Person{Fullname: "Jane Doe", Email: "jane.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 58, 12, 0, time.UTC),
+					"Add feature 3 (#1339)",
+				)
+
+				tracker.AddIssue("1234", "Subject of feature 1")
+				tracker.AddIssueWithAuthor("1337", "Subject of feature 2", "janedoe")
+				tracker.AddIssueWithAuthor("1338", "Bump dependency", "dependabot[bot]")
+				tracker.AddIssueWithAuthor("1339", "Subject of feature 3", "janedoe")
+
+				return &configuration.ValidatedConfig{
+					Repository:          repo,
+					BugTracker:          tracker,
+					From:                git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
+					To:                  git.Reference("6398b4e189b94ce300641431d3dfa00c373d1bb1"),
+					VersionName:         "v1.0.1",
+					Date:                time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
+					CommitParser:        github.NewSquashParser(),
+					Formatter:           formatter.NewMarkdownFormatter(),
+					Contributors:        true,
+					IgnoredContributors: []string{"dependabot[bot]"},
+				}
+			},
+			IsValid:      true,
+			ErrorMessage: "",
+			ExpectedOutput: `## v1.0.1 - 2018-11-22
+
+- Subject of feature 1 ([#1234])
+- Subject of feature 2 ([#1337])
+- Bump dependency ([#1338])
+- Subject of feature 3 ([#1339])
+
+### Contributors
+
+- [@janedoe](https://bugtracker.com/user/janedoe) (first contribution)
+- John Doe
+
+[#1234]: https://bugtracker.com/issue/1234
+[#1337]: https://bugtracker.com/issue/1337
+[#1338]: https://bugtracker.com/issue/1338
+[#1339]: https://bugtracker.com/issue/1339
+`,
+		},
+		{
+			Name: "When contributors come from merged pull-requests",
+			BuildConfiguration: func() *configuration.ValidatedConfig {
+				tracker := bugtracker.NewBugTracker()
+				repo := repository.New("git@github.com/kdisneur/changelog")
+
+				repo.AddCommit(
+					"7f76fa251d611ed48de62c460ec8f1b00804486b",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 53, 12, 0, time.UTC),
+					"initial Commit",
+				)
+
+				repo.AddMergeCommit(
+					"16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Merge pull request #1340 from janedoe/feature-1",
+				)
+				repo.AddBranchCommit(
+					"16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4^2",
+					"a2bc4fd34ba164ad0c1a264340ce37b0dbdaa6ef",
+					git.Person{Fullname: "Jane Doe", Email: "jane.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 54, 12, 0, time.UTC),
+					"Add feature 1",
+					"",
+				)
+
+				repo.AddMergeCommit(
+					"854da8029c41f552de16b81f7aba0e407a6bcb1c",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 58, 12, 0, time.UTC),
+					"Merge pull request #1341 from johndoe/feature-2",
+				)
+				repo.AddBranchCommit(
+					"854da8029c41f552de16b81f7aba0e407a6bcb1c^2",
+					"4f28c412c51c44c94daa3fced544567c3f94dd7b",
+					git.Person{Fullname: "John Doe", Email: "john@doe.dev"},
+					time.Date(2018, time.November, 22, 5, 57, 12, 0, time.UTC),
+					"Add feature 2",
+					"",
+				)
+
+				tracker.AddIssueWithAuthor("1340", "Subject of feature 1", "janedoe")
+				tracker.AddIssue("1341", "Subject of feature 2")
+
+				return &configuration.ValidatedConfig{
+					Repository:   repo,
+					BugTracker:   tracker,
+					From:         git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
+					To:           git.Reference("854da8029c41f552de16b81f7aba0e407a6bcb1c"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
+					CommitParser: github.NewMergeParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Contributors: true,
+				}
+			},
+			IsValid:      true,
+			ErrorMessage: "",
+			ExpectedOutput: `## v1.0.1 - 2018-11-22
+
+- Subject of feature 1 ([#1340])
+- Subject of feature 2 ([#1341])
+
+### Contributors
+
+- [@janedoe](https://bugtracker.com/user/janedoe) (first contribution)
+- John Doe (first contribution)
+
+[#1340]: https://bugtracker.com/issue/1340
+[#1341]: https://bugtracker.com/issue/1341
+`,
+		},
+		{
+			Name: "When issues are excluded by the filter",
+			BuildConfiguration: func() *configuration.ValidatedConfig {
+				tracker := bugtracker.NewBugTracker()
+				repo := repository.New("git@github.com/kdisneur/changelog")
+
+				repo.AddCommit(
+					"7f76fa251d611ed48de62c460ec8f1b00804486b",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 53, 12, 0, time.UTC),
+					"initial Commit",
+				)
+
+				repo.AddCommit(
+					"16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Add feature 1 (#1234)",
+				)
+
+				repo.AddCommit(
+					"854da8029c41f552de16b81f7aba0e407a6bcb1c",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Bump dependency (#1337)",
+				)
+
+				tracker.AddIssue("1234", "Subject of feature 1")
+				tracker.AddIssueWithLabels("1337", "Bump dependency", "dependencies")
+
+				exclude, _ := filter.NewRules([]string{"dependencies"}, nil, nil)
+
+				return &configuration.ValidatedConfig{
+					Repository:   repo,
+					BugTracker:   tracker,
+					From:         git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
+					To:           git.Reference("854da8029c41f552de16b81f7aba0e407a6bcb1c"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Filter:       filter.Filter{Exclude: exclude},
+				}
+			},
+			IsValid:      true,
+			ErrorMessage: "",
+			ExpectedOutput: `## v1.0.1 - 2018-11-22
+
+- Subject of feature 1 ([#1234])
+
+[#1234]: https://bugtracker.com/issue/1234
+`,
+		},
+		{
+			Name: "When release notes are extracted from the issue body",
+			BuildConfiguration: func() *configuration.ValidatedConfig {
+				tracker := bugtracker.NewBugTracker()
+				repo := repository.New("git@github.com/kdisneur/changelog")
+
+				repo.AddCommit(
+					"7f76fa251d611ed48de62c460ec8f1b00804486b",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 53, 12, 0, time.UTC),
+					"initial Commit",
+				)
+
+				repo.AddCommit(
+					"16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Add feature 1 (#1234)",
+				)
+
+				repo.AddCommit(
+					"854da8029c41f552de16b81f7aba0e407a6bcb1c",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Refactor parser (#1337)",
+				)
+
+				repo.AddCommit(
+					"555475c1e0c506eaf23d0db155f6592f7383c495",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 57, 12, 0, time.UTC),
+					"Add feature 3 (#1338)",
+				)
+
+				tracker.AddIssueWithBody("1234", "Subject of feature 1", "### Release notes\n\nUsers can log in with GitHub\n")
+				tracker.AddIssueWithBody("1337", "Refactor parser", "```release-note\nNONE\n```")
+				tracker.AddIssueWithBody("1338", "Subject of feature 3", "No release notes")
+
+				return &configuration.ValidatedConfig{
+					Repository:   repo,
+					BugTracker:   tracker,
+					From:         git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
+					To:           git.Reference("555475c1e0c506eaf23d0db155f6592f7383c495"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					ReleaseNotes: releasenote.NewExtractor("", ""),
+				}
+			},
+			IsValid:      true,
+			ErrorMessage: "",
+			ExpectedOutput: `## v1.0.1 - 2018-11-22
+
+- Users can log in with GitHub ([#1234])
+- Subject of feature 3 ([#1338])
+
+[#1234]: https://bugtracker.com/issue/1234
+[#1338]: https://bugtracker.com/issue/1338
+`,
+		},
+		{
+			Name: "When commits reference several issues",
+			BuildConfiguration: func() *configuration.ValidatedConfig {
+				tracker := bugtracker.NewBugTracker()
+				repo := repository.New("git@github.com/kdisneur/changelog")
+
+				repo.AddCommit(
+					"7f76fa251d611ed48de62c460ec8f1b00804486b",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 53, 12, 0, time.UTC),
+					"initial Commit",
+				)
+
+				repo.AddCommit(
+					"16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Fix login (#12) (#15)",
+				)
+
+				repo.AddCommit(
+					"854da8029c41f552de16b81f7aba0e407a6bcb1c",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"APP-7 Add feature (#16)",
+				)
+
+				repo.AddCommit(
+					"555475c1e0c506eaf23d0db155f6592f7383c495",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 57, 12, 0, time.UTC),
+					"Polish login (#12)",
+				)
+
+				tracker.AddIssue("12", "Fix login")
+				tracker.AddIssue("15", "Fix login again")
+				tracker.AddIssue("16", "Add feature")
+
+				jiraParser, _ := parser.NewTypedRegexParser("jira", "\\b(?P<id>[A-Z]+-[0-9]+)\\b", "", "", "jira", "https://jira.example.com/browse/{id}")
+
+				return &configuration.ValidatedConfig{
+					Repository:   repo,
+					BugTracker:   tracker,
+					From:         git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
+					To:           git.Reference("555475c1e0c506eaf23d0db155f6592f7383c495"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
+					CommitParser: parser.NewChainParser(github.NewSquashParser(), jiraParser),
+					Formatter:    formatter.NewMarkdownFormatter(),
+				}
+			},
+			IsValid:      true,
+			ErrorMessage: "",
+			ExpectedOutput: `## v1.0.1 - 2018-11-22
+
+- Fix login ([#12])
+- Fix login again ([#15])
+- Add feature ([#16])
+- APP-7 Add feature (#16) ([APP-7])
+
+[#12]: https://bugtracker.com/issue/12
+[#15]: https://bugtracker.com/issue/15
+[#16]: https://bugtracker.com/issue/16
+[APP-7]: https://jira.example.com/browse/APP-7
 `,
 		},
 		{
@@ -90,30 +428,558 @@ func TestBuildChangelog(t *testing.T) {
 				repo.AddCommit(
 					"854da8029c41f552de16b81f7aba0e407a6bcb1c",
 					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
-					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Add feature 2 (#1337)",
+				)
+
+				tracker.AddIssue("1234", "Subject of feature 1")
+				tracker.AddIssue("1337", "Subject of feature 2")
+
+				return &configuration.ValidatedConfig{
+					Repository:   repo,
+					BugTracker:   tracker,
+					From:         git.Reference("16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4"),
+					To:           git.Reference("16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+				}
+			},
+			IsValid:        false,
+			ErrorMessage:   "no commits found",
+			ExpectedOutput: "",
+		},
+		{
+			Name: "When the unreleased section has commits",
+			BuildConfiguration: func() *configuration.ValidatedConfig {
+				tracker := bugtracker.NewBugTracker()
+				repo := repository.New("git@github.com/kdisneur/changelog")
+
+				repo.AddCommit(
+					"7f76fa251d611ed48de62c460ec8f1b00804486b",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 53, 12, 0, time.UTC),
+					"initial Commit",
+				)
+
+				repo.AddCommit(
+					"16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Add feature 1 (#1234)",
+				)
+
+				tracker.AddIssue("1234", "Subject of feature 1")
+
+				return &configuration.ValidatedConfig{
+					Repository:   repo,
+					BugTracker:   tracker,
+					From:         git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
+					To:           git.Reference("16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4"),
+					VersionName:  configuration.UnreleasedVersionName,
+					Date:         time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Unreleased:   true,
+				}
+			},
+			IsValid:      true,
+			ErrorMessage: "",
+			ExpectedOutput: `## [Unreleased]
+
+- Subject of feature 1 ([#1234])
+
+[#1234]: https://bugtracker.com/issue/1234
+`,
+		},
+		{
+			Name: "When the heading links to the comparison",
+			BuildConfiguration: func() *configuration.ValidatedConfig {
+				tracker := bugtracker.NewBugTracker()
+				repo := repository.New("git@github.com/kdisneur/changelog")
+
+				repo.AddBranchCommit(
+					"master",
+					"16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Add feature 1 (#1234)",
+					"",
+				)
+
+				tracker.AddIssue("1234", "Subject of feature 1")
+
+				return &configuration.ValidatedConfig{
+					Repository:   repo,
+					BugTracker:   tracker,
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("master"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Remote:       &git.Remote{Type: git.GIT, Host: "github.com", RepositoryName: "kdisneur/changelog"},
+					CompareLinks: true,
+				}
+			},
+			IsValid:      true,
+			ErrorMessage: "",
+			ExpectedOutput: `## [v1.0.1](https://github.com/kdisneur/changelog/compare/v1.0.0...v1.0.1) - 2018-11-22
+
+- Subject of feature 1 ([#1234])
+
+[#1234]: https://bugtracker.com/issue/1234
+`,
+		},
+		{
+			Name: "When the Debian changelog takes the maintainer from the latest committer",
+			BuildConfiguration: func() *configuration.ValidatedConfig {
+				tracker := bugtracker.NewBugTracker()
+				repo := repository.New("git@github.com/kdisneur/changelog")
+
+				repo.AddBranchCommit(
+					"master",
+					"16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Add feature 1 (#1234)",
+					"",
+				)
+
+				repo.AddBranchCommit(
+					"master",
+					"854da8029c41f552de16b81f7aba0e407a6bcb1c",
+					git.Person{Fullname: "Jane Doe", Email: "jane.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 58, 12, 0, time.UTC),
+					"Add feature 2 (#1337)",
+					"",
+				)
+
+				tracker.AddIssue("1234", "Subject of feature 1")
+				tracker.AddIssue("1337", "Subject of feature 2")
+
+				return &configuration.ValidatedConfig{
+					Repository:   repo,
+					BugTracker:   tracker,
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("master"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewDebianFormatter(),
+					Remote:       &git.Remote{Type: git.GIT, Host: "github.com", RepositoryName: "kdisneur/changelog"},
+				}
+			},
+			IsValid:      true,
+			ErrorMessage: "",
+			ExpectedOutput: `changelog (1.0.1) unstable; urgency=medium
+
+  * Subject of feature 1 (#1234)
+  * Subject of feature 2 (#1337)
+
+ -- Jane Doe <jane.doe@gmail.com>  Thu, 22 Nov 2018 05:59:25 +0000
+`,
+		},
+		{
+			Name: "When the unreleased section has no commits",
+			BuildConfiguration: func() *configuration.ValidatedConfig {
+				tracker := bugtracker.NewBugTracker()
+				repo := repository.New("git@github.com/kdisneur/changelog")
+
+				repo.AddCommit(
+					"7f76fa251d611ed48de62c460ec8f1b00804486b",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 53, 12, 0, time.UTC),
+					"initial Commit",
+				)
+
+				return &configuration.ValidatedConfig{
+					Repository:   repo,
+					BugTracker:   tracker,
+					From:         git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
+					To:           git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
+					VersionName:  configuration.UnreleasedVersionName,
+					Date:         time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Unreleased:   true,
+				}
+			},
+			IsValid:        true,
+			ErrorMessage:   "",
+			ExpectedOutput: "## [Unreleased]\n\n(No changes)\n",
+		},
+		{
+			Name: "When log contains unparsable commits",
+			BuildConfiguration: func() *configuration.ValidatedConfig {
+				tracker := bugtracker.NewBugTracker()
+				repo := repository.New("git@github.com/kdisneur/changelog")
+
+				repo.AddCommit(
+					"7f76fa251d611ed48de62c460ec8f1b00804486b",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 53, 12, 0, time.UTC),
+					"initial Commit",
+				)
+
+				repo.AddCommit(
+					"16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Add feature 1 (#1234)",
+				)
+
+				repo.AddCommit(
+					"854da8029c41f552de16b81f7aba0e407a6bcb1c",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Add feature 2 #1337 with a wrong format",
+				)
+
+				tracker.AddIssue("1234", "Subject of feature 1")
+				tracker.AddIssue("1337", "Subject of feature 2")
+
+				return &configuration.ValidatedConfig{
+					Repository:   repo,
+					BugTracker:   tracker,
+					From:         git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
+					To:           git.Reference("854da8029c41f552de16b81f7aba0e407a6bcb1c"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+				}
+			},
+			IsValid:      true,
+			ErrorMessage: "",
+			ExpectedOutput: `## v1.0.1 - 2018-11-22
+
+- Subject of feature 1 ([#1234])
+
+[#1234]: https://bugtracker.com/issue/1234
+`,
+		},
+		{
+			Name: "When a commit contains a reference to a non existing issue",
+			BuildConfiguration: func() *configuration.ValidatedConfig {
+				tracker := bugtracker.NewBugTracker()
+				repo := repository.New("git@github.com/kdisneur/changelog")
+
+				repo.AddCommit(
+					"7f76fa251d611ed48de62c460ec8f1b00804486b",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 53, 12, 0, time.UTC),
+					"initial Commit",
+				)
+
+				repo.AddCommit(
+					"16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Add feature 1 (#1234)",
+				)
+
+				repo.AddCommit(
+					"854da8029c41f552de16b81f7aba0e407a6bcb1c",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Add feature 2 (#1337)",
+				)
+
+				tracker.AddIssue("42", "Subject of feature 1")
+				tracker.AddIssue("1337", "Subject of feature 2")
+
+				return &configuration.ValidatedConfig{
+					Repository:   repo,
+					BugTracker:   tracker,
+					From:         git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
+					To:           git.Reference("854da8029c41f552de16b81f7aba0e407a6bcb1c"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+				}
+			},
+			IsValid:        false,
+			ErrorMessage:   "no issues with ID: 1234",
+			ExpectedOutput: "",
+		},
+		{
+			Name: "When direct commits are enabled",
+			BuildConfiguration: func() *configuration.ValidatedConfig {
+				tracker := bugtracker.NewBugTracker()
+				repo := repository.New("git@github.com/kdisneur/changelog")
+
+				repo.AddCommit(
+					"7f76fa251d611ed48de62c460ec8f1b00804486b",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 53, 12, 0, time.UTC),
+					"initial Commit",
+				)
+
+				repo.AddCommit(
+					"16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Add feature 1 (#1234)",
+				)
+
+				repo.AddCommit(
+					"a1b2c3d4e5f60718293a4b5c6d7e8f9012345678",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 57, 12, 0, time.UTC),
+					"Fix production crash",
+				)
+
+				repo.AddCommit(
+					"854da8029c41f552de16b81f7aba0e407a6bcb1c",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 58, 12, 0, time.UTC),
+					"Bump version to v1.0.1",
+				)
+
+				tracker.AddIssue("1234", "Subject of feature 1")
+
+				return &configuration.ValidatedConfig{
+					Repository:           repo,
+					BugTracker:           tracker,
+					Remote:               &git.Remote{Type: git.GIT, Host: "github.com", RepositoryName: "kdisneur/changelog"},
+					From:                 git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
+					To:                   git.Reference("854da8029c41f552de16b81f7aba0e407a6bcb1c"),
+					VersionName:          "v1.0.1",
+					Date:                 time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
+					CommitParser:         github.NewSquashParser(),
+					Formatter:            formatter.NewMarkdownFormatter(),
+					DirectCommits:        true,
+					IgnoredDirectCommits: []*regexp.Regexp{regexp.MustCompile("^Bump version")},
+				}
+			},
+			IsValid:      true,
+			ErrorMessage: "",
+			ExpectedOutput: `## v1.0.1 - 2018-11-22
+
+- Subject of feature 1 ([#1234])
+
+### Other changes
+
+- Fix production crash ([a1b2c3d])
+
+[#1234]: https://bugtracker.com/issue/1234
+[a1b2c3d]: https://github.com/kdisneur/changelog/commit/a1b2c3d4e5f60718293a4b5c6d7e8f9012345678
+`,
+		},
+		{
+			Name: "When direct commits are enabled with the merge strategy",
+			BuildConfiguration: func() *configuration.ValidatedConfig {
+				tracker := bugtracker.NewBugTracker()
+				repo := repository.New("git@github.com/kdisneur/changelog")
+				author := git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"}
+
+				repo.AddCommit(
+					"7f76fa251d611ed48de62c460ec8f1b00804486b",
+					author,
+					time.Date(2018, time.November, 22, 5, 53, 12, 0, time.UTC),
+					"initial Commit",
+				)
+
+				repo.AddCommitWithParents(
+					"a2bc4fd34ba164ad0c1a264340ce37b0dbdaa6ef",
+					author,
+					time.Date(2018, time.November, 22, 5, 54, 12, 0, time.UTC),
+					"Work on feature 1",
+					"7f76fa251d611ed48de62c460ec8f1b00804486b",
+				)
+
+				repo.AddCommitWithParents(
+					"4f28c412c51c44c94daa3fced544567c3f94dd7b",
+					author,
+					time.Date(2018, time.November, 22, 5, 55, 12, 0, time.UTC),
+					"Fix typo in feature 1",
+					"a2bc4fd34ba164ad0c1a264340ce37b0dbdaa6ef",
+				)
+
+				repo.AddCommitWithParents(
+					"a1b2c3d4e5f60718293a4b5c6d7e8f9012345678",
+					author,
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Fix production crash",
+					"7f76fa251d611ed48de62c460ec8f1b00804486b",
+				)
+
+				repo.AddCommitWithParents(
+					"16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4",
+					author,
+					time.Date(2018, time.November, 22, 5, 57, 12, 0, time.UTC),
+					"Merge pull request #1234 from johndoe/feature-1",
+					"a1b2c3d4e5f60718293a4b5c6d7e8f9012345678",
+					"4f28c412c51c44c94daa3fced544567c3f94dd7b",
+				)
+
+				repo.AddCommitWithParents(
+					"854da8029c41f552de16b81f7aba0e407a6bcb1c",
+					author,
+					time.Date(2018, time.November, 22, 5, 58, 12, 0, time.UTC),
+					"Bump version to v1.0.1",
+					"16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4",
+				)
+
+				tracker.AddIssue("1234", "Subject of feature 1")
+
+				return &configuration.ValidatedConfig{
+					Repository:           repo,
+					BugTracker:           tracker,
+					Remote:               &git.Remote{Type: git.GIT, Host: "github.com", RepositoryName: "kdisneur/changelog"},
+					From:                 git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
+					To:                   git.Reference("854da8029c41f552de16b81f7aba0e407a6bcb1c"),
+					VersionName:          "v1.0.1",
+					Date:                 time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
+					CommitParser:         github.NewMergeParser(),
+					Formatter:            formatter.NewMarkdownFormatter(),
+					DirectCommits:        true,
+					IgnoredDirectCommits: []*regexp.Regexp{regexp.MustCompile("^Bump version")},
+				}
+			},
+			IsValid:      true,
+			ErrorMessage: "",
+			ExpectedOutput: `## v1.0.1 - 2018-11-22
+
+- Subject of feature 1 ([#1234])
+
+### Other changes
+
+- Fix production crash ([a1b2c3d])
+
+[#1234]: https://bugtracker.com/issue/1234
+[a1b2c3d]: https://github.com/kdisneur/changelog/commit/a1b2c3d4e5f60718293a4b5c6d7e8f9012345678
+`,
+		},
+		{
+			Name: "When a change is reverted in the same range",
+			BuildConfiguration: func() *configuration.ValidatedConfig {
+				tracker := bugtracker.NewBugTracker()
+				repo := repository.New("git@github.com/kdisneur/changelog")
+
+				repo.AddCommit(
+					"7f76fa251d611ed48de62c460ec8f1b00804486b",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 53, 12, 0, time.UTC),
+					"initial Commit",
+				)
+
+				repo.AddCommit(
+					"16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Add feature 1 (#1234)",
+				)
+
+				repo.AddCommit(
+					"854da8029c41f552de16b81f7aba0e407a6bcb1c",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 57, 12, 0, time.UTC),
+					"Add feature 2 (#1337)",
+				)
+
+				repo.AddCommitWithBody(
+					"a1b2c3d4e5f60718293a4b5c6d7e8f9012345678",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 58, 12, 0, time.UTC),
+					"Undo feature 1 (#1400)",
+					"This reverts commit 16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4.",
+				)
+
+				tracker.AddIssue("1234", "Subject of feature 1")
+				tracker.AddIssue("1337", "Subject of feature 2")
+				tracker.AddIssue("1400", "Revert feature 1")
+
+				return &configuration.ValidatedConfig{
+					Repository:   repo,
+					BugTracker:   tracker,
+					From:         git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
+					To:           git.Reference("a1b2c3d4e5f60718293a4b5c6d7e8f9012345678"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Reverts:      configuration.RevertsCancel,
+				}
+			},
+			IsValid:      true,
+			ErrorMessage: "",
+			ExpectedOutput: `## v1.0.1 - 2018-11-22
+
+- Subject of feature 2 ([#1337])
+
+[#1337]: https://bugtracker.com/issue/1337
+`,
+		},
+		{
+			Name: "When reverted changes are listed",
+			BuildConfiguration: func() *configuration.ValidatedConfig {
+				tracker := bugtracker.NewBugTracker()
+				repo := repository.New("git@github.com/kdisneur/changelog")
+
+				repo.AddCommit(
+					"7f76fa251d611ed48de62c460ec8f1b00804486b",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 53, 12, 0, time.UTC),
+					"initial Commit",
+				)
+
+				repo.AddCommit(
+					"16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					"Add feature 1 (#1234)",
+				)
+
+				repo.AddCommit(
+					"854da8029c41f552de16b81f7aba0e407a6bcb1c",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 57, 12, 0, time.UTC),
 					"Add feature 2 (#1337)",
 				)
 
+				repo.AddCommit(
+					"a1b2c3d4e5f60718293a4b5c6d7e8f9012345678",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 58, 12, 0, time.UTC),
+					`Revert "Add feature 1 (#1234)" (#1400)`,
+				)
+
 				tracker.AddIssue("1234", "Subject of feature 1")
 				tracker.AddIssue("1337", "Subject of feature 2")
+				tracker.AddIssue("1400", "Revert feature 1")
 
 				return &configuration.ValidatedConfig{
 					Repository:   repo,
 					BugTracker:   tracker,
-					From:         git.Reference("16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4"),
-					To:           git.Reference("16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4"),
+					From:         git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
+					To:           git.Reference("a1b2c3d4e5f60718293a4b5c6d7e8f9012345678"),
 					VersionName:  "v1.0.1",
 					Date:         time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
 					CommitParser: github.NewSquashParser(),
 					Formatter:    formatter.NewMarkdownFormatter(),
+					Reverts:      configuration.RevertsList,
 				}
 			},
-			IsValid:        false,
-			ErrorMessage:   "no commits found",
-			ExpectedOutput: "",
+			IsValid:      true,
+			ErrorMessage: "",
+			ExpectedOutput: `## v1.0.1 - 2018-11-22
+
+- Subject of feature 2 ([#1337])
+
+### Reverted
+
+- Subject of feature 1 ([#1234])
+
+[#1337]: https://bugtracker.com/issue/1337
+[#1234]: https://bugtracker.com/issue/1234
+`,
 		},
 		{
-			Name: "When log contains unparsable commits",
+			Name: "When reverted changes are kept",
 			BuildConfiguration: func() *configuration.ValidatedConfig {
 				tracker := bugtracker.NewBugTracker()
 				repo := repository.New("git@github.com/kdisneur/changelog")
@@ -135,22 +1001,31 @@ func TestBuildChangelog(t *testing.T) {
 				repo.AddCommit(
 					"854da8029c41f552de16b81f7aba0e407a6bcb1c",
 					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
-					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
-					"Add feature 2 #1337 with a wrong format",
+					time.Date(2018, time.November, 22, 5, 57, 12, 0, time.UTC),
+					"Add feature 2 (#1337)",
+				)
+
+				repo.AddCommit(
+					"a1b2c3d4e5f60718293a4b5c6d7e8f9012345678",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 58, 12, 0, time.UTC),
+					`Revert "Add feature 1 (#1234)" (#1400)`,
 				)
 
 				tracker.AddIssue("1234", "Subject of feature 1")
 				tracker.AddIssue("1337", "Subject of feature 2")
+				tracker.AddIssue("1400", "Revert feature 1")
 
 				return &configuration.ValidatedConfig{
 					Repository:   repo,
 					BugTracker:   tracker,
 					From:         git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
-					To:           git.Reference("854da8029c41f552de16b81f7aba0e407a6bcb1c"),
+					To:           git.Reference("a1b2c3d4e5f60718293a4b5c6d7e8f9012345678"),
 					VersionName:  "v1.0.1",
 					Date:         time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
 					CommitParser: github.NewSquashParser(),
 					Formatter:    formatter.NewMarkdownFormatter(),
+					Reverts:      configuration.RevertsKeep,
 				}
 			},
 			IsValid:      true,
@@ -158,12 +1033,16 @@ func TestBuildChangelog(t *testing.T) {
 			ExpectedOutput: `## v1.0.1 - 2018-11-22
 
 - Subject of feature 1 ([#1234])
+- Subject of feature 2 ([#1337])
+- Revert feature 1 ([#1400])
 
 [#1234]: https://bugtracker.com/issue/1234
+[#1337]: https://bugtracker.com/issue/1337
+[#1400]: https://bugtracker.com/issue/1400
 `,
 		},
 		{
-			Name: "When a commit contains a reference to a non existing issue",
+			Name: "When changes already shipped in a maintenance branch",
 			BuildConfiguration: func() *configuration.ValidatedConfig {
 				tracker := bugtracker.NewBugTracker()
 				repo := repository.New("git@github.com/kdisneur/changelog")
@@ -182,14 +1061,31 @@ func TestBuildChangelog(t *testing.T) {
 					"Add feature 1 (#1234)",
 				)
 
+				repo.AddCommit(
+					"a1b2c3d4e5f60718293a4b5c6d7e8f9012345678",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 57, 12, 0, time.UTC),
+					"Fix crash (#1300)",
+				)
+
 				repo.AddCommit(
 					"854da8029c41f552de16b81f7aba0e407a6bcb1c",
 					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
-					time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+					time.Date(2018, time.November, 22, 5, 58, 12, 0, time.UTC),
 					"Add feature 2 (#1337)",
 				)
 
-				tracker.AddIssue("42", "Subject of feature 1")
+				repo.AddBranchCommit(
+					"release-1.x",
+					"0c1d2e3f405162738495a6b7c8d9e0f1a2b3c4d5",
+					git.Person{Fullname: "Jane Doe", Email: "jane.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 6, 10, 12, 0, time.UTC),
+					"Fix crash on the 1.x branch",
+					"(cherry picked from commit a1b2c3d4e5f60718293a4b5c6d7e8f9012345678)",
+				)
+
+				tracker.AddIssue("1234", "Subject of feature 1")
+				tracker.AddIssue("1300", "Fix the crash")
 				tracker.AddIssue("1337", "Subject of feature 2")
 
 				return &configuration.ValidatedConfig{
@@ -197,15 +1093,67 @@ func TestBuildChangelog(t *testing.T) {
 					BugTracker:   tracker,
 					From:         git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
 					To:           git.Reference("854da8029c41f552de16b81f7aba0e407a6bcb1c"),
-					VersionName:  "v1.0.1",
+					VersionName:  "v1.1.0",
 					Date:         time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
 					CommitParser: github.NewSquashParser(),
 					Formatter:    formatter.NewMarkdownFormatter(),
+					ShippedIn:    []git.Reference{"release-1.x"},
 				}
 			},
-			IsValid:        false,
-			ErrorMessage:   "no issues with ID: 1234",
-			ExpectedOutput: "",
+			IsValid:      true,
+			ErrorMessage: "",
+			ExpectedOutput: `## v1.1.0 - 2018-11-22
+
+- Subject of feature 1 ([#1234])
+- Subject of feature 2 ([#1337])
+
+[#1234]: https://bugtracker.com/issue/1234
+[#1337]: https://bugtracker.com/issue/1337
+`,
+		},
+		{
+			Name: "When a cherry-picked commit doesn't reference its pull request",
+			BuildConfiguration: func() *configuration.ValidatedConfig {
+				tracker := bugtracker.NewBugTracker()
+				repo := repository.New("git@github.com/kdisneur/changelog")
+
+				repo.AddCommit(
+					"a1b2c3d4e5f60718293a4b5c6d7e8f9012345678",
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 57, 12, 0, time.UTC),
+					"Fix crash (#1300)",
+				)
+
+				repo.AddBranchCommit(
+					"release-1.x",
+					"0c1d2e3f405162738495a6b7c8d9e0f1a2b3c4d5",
+					git.Person{Fullname: "Jane Doe", Email: "jane.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 6, 10, 12, 0, time.UTC),
+					"Fix crash on the 1.x branch",
+					"(cherry picked from commit a1b2c3d4e5f60718293a4b5c6d7e8f9012345678)",
+				)
+
+				tracker.AddIssue("1300", "Fix the crash")
+
+				return &configuration.ValidatedConfig{
+					Repository:   repo,
+					BugTracker:   tracker,
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("release-1.x"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 22, 6, 15, 25, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+				}
+			},
+			IsValid:      true,
+			ErrorMessage: "",
+			ExpectedOutput: `## v1.0.1 - 2018-11-22
+
+- Fix the crash ([#1300])
+
+[#1300]: https://bugtracker.com/issue/1300
+`,
 		},
 		{
 			Name: "When no issues are found in matching commits",
@@ -278,3 +1226,387 @@ func TestBuildChangelog(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildWhenTolerant(t *testing.T) {
+	tracker := bugtracker.NewBugTracker()
+	repo := repository.New("git@github.com/kdisneur/changelog")
+
+	repo.AddCommit(
+		"7f76fa251d611ed48de62c460ec8f1b00804486b",
+		git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+		time.Date(2018, time.November, 22, 5, 53, 12, 0, time.UTC),
+		"initial Commit",
+	)
+
+	repo.AddCommit(
+		"16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4",
+		git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+		time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+		"Add feature 1 (#1234) (#1235)",
+	)
+
+	repo.AddCommit(
+		"854da8029c41f552de16b81f7aba0e407a6bcb1c",
+		git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+		time.Date(2018, time.November, 22, 5, 56, 12, 0, time.UTC),
+		"Add feature 2 (#1337)",
+	)
+
+	tracker.AddIssue("1337", "Subject of feature 2")
+
+	config := &configuration.ValidatedConfig{
+		Repository:   repo,
+		Remote:       &git.Remote{Type: git.GIT, Host: "github.com", RepositoryName: "kdisneur/changelog"},
+		BugTracker:   tracker,
+		From:         git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
+		To:           git.Reference("854da8029c41f552de16b81f7aba0e407a6bcb1c"),
+		VersionName:  "v1.0.1",
+		Date:         time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
+		CommitParser: github.NewSquashParser(),
+		Formatter:    formatter.NewMarkdownFormatter(),
+		Tolerant:     true,
+	}
+
+	result, err := changelog.Build(config)
+	if err != nil {
+		t.Fatalf("Expected no errors but got: %s", err.Error())
+	}
+
+	expectedOutput := `## v1.0.1 - 2018-11-22
+
+- Add feature 1 (#1234) (#1235) ([16dd997])
+- Subject of feature 2 ([#1337])
+
+[16dd997]: https://github.com/kdisneur/changelog/commit/16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4
+[#1337]: https://bugtracker.com/issue/1337
+`
+
+	if result.Changelog != expectedOutput {
+		t.Fatalf("Wrong output. Expected:\n%s\nReceived:\n%s", expectedOutput, result.Changelog)
+	}
+
+	if len(result.Failures) != 2 {
+		t.Fatalf("Expected 2 failures, received: %+v", result.Failures)
+	}
+
+	for index, expectedID := range []string{"1234", "1235"} {
+		failure := result.Failures[index]
+
+		if failure.Reference.ID != expectedID || failure.Commit.ID != "16dd9970c4f776157ccc6a7d8c78b2bdeeaab1c4" {
+			t.Errorf("Wrong failure. Expected reference %s, received: %+v", expectedID, failure)
+		}
+
+		if !strings.Contains(failure.Err.Error(), fmt.Sprintf("no issues with ID: %s", expectedID)) {
+			t.Errorf("Wrong failure error. Received: %s", failure.Err.Error())
+		}
+	}
+}
+
+func TestBuildExplanations(t *testing.T) {
+	testCases := []struct {
+		Name                 string
+		Commits              []string
+		Exclude              []string
+		IsValid              bool
+		ExpectedExplanations []string
+	}{
+		{
+			Name:    "When commits are kept, cached, excluded and dropped",
+			Commits: []string{"Add feature 1 (#1234)", "Fix feature 1 (#1234)", "Add internal tooling (#1300)", "Bump version"},
+			Exclude: []string{"internal"},
+			IsValid: true,
+			ExpectedExplanations: []string{
+				"true|pull-request 1234: fetched|changes",
+				"true|pull-request 1234: cached|changes",
+				"true|pull-request 1300: fetched|excluded (excluded by label 'internal')",
+				"false||dropped (no reference)",
+			},
+		},
+		{
+			Name:    "When no commits are kept",
+			Commits: []string{"Bump version", "Update README"},
+			IsValid: false,
+			ExpectedExplanations: []string{
+				"false||dropped (no reference)",
+				"false||dropped (no reference)",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			tracker := bugtracker.NewBugTracker()
+			repo := repository.New("git@github.com/kdisneur/changelog")
+
+			repo.AddCommit(
+				"7f76fa251d611ed48de62c460ec8f1b00804486b",
+				git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+				time.Date(2018, time.November, 22, 5, 53, 12, 0, time.UTC),
+				"initial Commit",
+			)
+
+			for index, message := range testCase.Commits {
+				repo.AddCommit(
+					fmt.Sprintf("%040d", index+1),
+					git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+					time.Date(2018, time.November, 22, 5, 56, index, 0, time.UTC),
+					message,
+				)
+			}
+
+			tracker.AddIssue("1234", "Subject of feature 1")
+			tracker.AddIssueWithLabels("1300", "Internal tooling", "internal")
+
+			exclude, _ := filter.NewRules(testCase.Exclude, nil, nil)
+
+			config := &configuration.ValidatedConfig{
+				Repository:   repo,
+				BugTracker:   tracker,
+				From:         git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
+				To:           git.Reference(fmt.Sprintf("%040d", len(testCase.Commits))),
+				VersionName:  "v1.0.1",
+				Date:         time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
+				CommitParser: github.NewSquashParser(),
+				Formatter:    formatter.NewMarkdownFormatter(),
+				Filter:       filter.Filter{Exclude: exclude},
+			}
+
+			result, err := changelog.Build(config)
+			if err != nil && testCase.IsValid {
+				t.Fatalf("Expected no errors but got: %s", err.Error())
+			}
+
+			if err == nil && !testCase.IsValid {
+				t.Fatalf("Expected errors but got none")
+			}
+
+			if len(result.Explanations) != len(testCase.ExpectedExplanations) {
+				t.Fatalf("Expected %d explanations, received: %d", len(testCase.ExpectedExplanations), len(result.Explanations))
+			}
+
+			for index, expected := range testCase.ExpectedExplanations {
+				explanation := result.Explanations[index]
+
+				var references []string
+				for _, reference := range explanation.References {
+					references = append(references, fmt.Sprintf("%s %s: %s", reference.Reference.Type, reference.Reference.ID, reference.Lookup))
+				}
+
+				actual := fmt.Sprintf("%t|%s|%s", explanation.Kept, strings.Join(references, ", "), explanation.Section)
+				if actual != expected {
+					t.Errorf("Wrong explanation for %s. Expected: %s\nReceived: %s", explanation.Commit.Message, expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildProfiles(t *testing.T) {
+	tracker := bugtracker.NewBugTracker()
+	repo := repository.New("git@github.com/kdisneur/changelog")
+
+	repo.AddCommit(
+		"7f76fa251d611ed48de62c460ec8f1b00804486b",
+		git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+		time.Date(2018, time.November, 22, 5, 53, 12, 0, time.UTC),
+		"initial Commit",
+	)
+	repo.AddCommit(
+		"0000000000000000000000000000000000000001",
+		git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+		time.Date(2018, time.November, 22, 5, 56, 1, 0, time.UTC),
+		"Add feature 1 (#1234)",
+	)
+	repo.AddCommit(
+		"0000000000000000000000000000000000000002",
+		git.Person{Fullname: "Jane Doe", Email: "jane.doe@gmail.com"},
+		time.Date(2018, time.November, 22, 5, 56, 2, 0, time.UTC),
+		"Add internal tooling (#1300)",
+	)
+
+	tracker.AddIssueWithAuthor("1234", "Subject of feature 1", "john")
+	tracker.AddIssueWithAuthor("1300", "Internal tooling", "jane")
+	tracker.Issues["1234"].Labels = []string{"user-facing"}
+	tracker.Issues["1300"].Labels = []string{"internal"}
+
+	publicInclude, _ := filter.NewRules([]string{"user-facing"}, nil, nil)
+
+	config := &configuration.ValidatedConfig{
+		Repository:   repo,
+		BugTracker:   tracker,
+		From:         git.Reference("7f76fa251d611ed48de62c460ec8f1b00804486b"),
+		To:           git.Reference("0000000000000000000000000000000000000002"),
+		VersionName:  "v1.0.1",
+		Date:         time.Date(2018, time.November, 22, 5, 59, 25, 0, time.UTC),
+		CommitParser: github.NewSquashParser(),
+		Formatter:    formatter.NewMarkdownFormatter(),
+		Profiles: []*configuration.ValidatedProfile{
+			{
+				Name:      "internal",
+				Formatter: formatter.NewMarkdownFormatter(),
+				Sections:  []string{configuration.SectionChanges, configuration.SectionContributors},
+			},
+			{
+				Name:      "public",
+				Formatter: formatter.NewMarkdownFormatter(),
+				Filter:    filter.Filter{Include: publicInclude},
+				Sections:  []string{configuration.SectionChanges},
+				HideLinks: true,
+			},
+		},
+	}
+
+	expectedRenderings := []string{
+		`## v1.0.1 - 2018-11-22
+
+- Subject of feature 1 ([#1234])
+- Internal tooling ([#1300])
+
+### Contributors
+
+- [@jane](https://bugtracker.com/user/jane) (first contribution)
+- [@john](https://bugtracker.com/user/john)
+
+[#1234]: https://bugtracker.com/issue/1234
+[#1300]: https://bugtracker.com/issue/1300
+`,
+		`## v1.0.1 - 2018-11-22
+
+- Subject of feature 1 (#1234)
+
+`,
+	}
+
+	result, err := changelog.Build(config)
+	if err != nil {
+		t.Fatalf("Expected no errors but got: %s", err.Error())
+	}
+
+	if len(result.Renderings) != len(expectedRenderings) {
+		t.Fatalf("Expected %d renderings, received: %d", len(expectedRenderings), len(result.Renderings))
+	}
+
+	for index, rendering := range result.Renderings {
+		if rendering.Changelog != expectedRenderings[index] {
+			t.Errorf("Wrong changelog for profile %s.\nExpected:\n%s\nReceived:\n%s", rendering.Profile.Name, expectedRenderings[index], rendering.Changelog)
+		}
+	}
+
+	if result.Changelog != expectedRenderings[0] {
+		t.Errorf("Expected the changelog to be the first rendering.\nReceived:\n%s", result.Changelog)
+	}
+
+	if len(result.Renderings[1].Excluded) != 1 || result.Renderings[1].Excluded[0].Issue.ID != "1300" {
+		t.Errorf("Expected the public profile to exclude #1300, received: %+v", result.Renderings[1].Excluded)
+	}
+}
+
+func TestBuildHistory(t *testing.T) {
+	tracker := bugtracker.NewBugTracker()
+	repo := repository.New("git@github.com/kdisneur/changelog")
+
+	repo.AddTag("v1.0.0", time.Date(2018, time.November, 19, 10, 0, 0, 0, time.UTC))
+	repo.AddTag("v1.1.0", time.Date(2018, time.November, 21, 10, 0, 0, 0, time.UTC))
+	repo.AddTag("v1.2.0", time.Date(2018, time.November, 23, 10, 0, 0, 0, time.UTC))
+	repo.AddTag("v1.3.0", time.Date(2018, time.November, 25, 10, 0, 0, 0, time.UTC))
+
+	repo.AddBranchCommit(
+		"v1.0.0",
+		"0000000000000000000000000000000000000000",
+		git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+		time.Date(2018, time.November, 18, 5, 56, 1, 0, time.UTC),
+		"Initial feature (#1000)",
+		"",
+	)
+	repo.AddBranchCommit(
+		"v1.1.0",
+		"0000000000000000000000000000000000000001",
+		git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+		time.Date(2018, time.November, 20, 5, 56, 1, 0, time.UTC),
+		"Add feature 1 (#1234)",
+		"",
+	)
+	repo.AddBranchCommit(
+		"v1.3.0",
+		"0000000000000000000000000000000000000003",
+		git.Person{Fullname: "John Doe", Email: "john.doe@gmail.com"},
+		time.Date(2018, time.November, 24, 5, 56, 1, 0, time.UTC),
+		"Add feature 3 (#1337)",
+		"",
+	)
+
+	tracker.AddIssue("1000", "Subject of the initial feature")
+	tracker.AddIssue("1234", "Subject of feature 1")
+	tracker.AddIssue("1337", "Subject of feature 3")
+
+	config := &configuration.ValidatedConfig{
+		Repository:   repo,
+		BugTracker:   tracker,
+		To:           git.Reference("master"),
+		Date:         time.Date(2018, time.November, 26, 5, 59, 25, 0, time.UTC),
+		CommitParser: github.NewSquashParser(),
+		Formatter:    formatter.NewMarkdownFormatter(),
+		Remote:       &git.Remote{Type: git.GIT, Host: "github.com", RepositoryName: "kdisneur/changelog"},
+	}
+
+	results, err := changelog.BuildHistory(config, 2)
+	if err != nil {
+		t.Fatalf("Expected no errors but got: %s", err.Error())
+	}
+
+	expectedChangelogs := []string{
+		`## v1.3.0 - 2018-11-25
+
+- Subject of feature 3 ([#1337])
+
+[#1337]: https://bugtracker.com/issue/1337
+`,
+		`## v1.2.0 - 2018-11-23
+
+(No changes)
+`,
+	}
+
+	if len(results) != len(expectedChangelogs) {
+		t.Fatalf("Expected %d releases, received %d", len(expectedChangelogs), len(results))
+	}
+
+	for index, expected := range expectedChangelogs {
+		if results[index].Changelog != expected {
+			t.Errorf("Wrong changelog %d. Expected:\n%s\nReceived:\n%s", index, expected, results[index].Changelog)
+		}
+	}
+
+	if link := results[0].Renderings[0].Release.Link; link != "https://github.com/kdisneur/changelog/releases/tag/v1.3.0" {
+		t.Errorf("Wrong release link. Received: %s", link)
+	}
+
+	all, err := changelog.BuildHistory(config, 0)
+	if err != nil {
+		t.Fatalf("Expected no errors but got: %s", err.Error())
+	}
+
+	if len(all) != 4 {
+		t.Fatalf("Expected 4 releases without limit, received %d", len(all))
+	}
+
+	expectedFirstRelease := `## v1.0.0 - 2018-11-19
+
+- Subject of the initial feature ([#1000])
+
+[#1000]: https://bugtracker.com/issue/1000
+`
+
+	if all[3].Changelog != expectedFirstRelease {
+		t.Errorf("Wrong first release. Expected:\n%s\nReceived:\n%s", expectedFirstRelease, all[3].Changelog)
+	}
+
+	tooMany, err := changelog.BuildHistory(config, 10)
+	if err != nil {
+		t.Fatalf("Expected no errors but got: %s", err.Error())
+	}
+
+	if len(tooMany) != 4 {
+		t.Errorf("Expected 4 releases with a limit above the number of tags, received %d", len(tooMany))
+	}
+}
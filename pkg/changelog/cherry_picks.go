@@ -0,0 +1,101 @@
+package changelog
+
+import (
+	"fmt"
+
+	"github.com/kdisneur/changelog/pkg/bugtracker"
+	"github.com/kdisneur/changelog/pkg/configuration"
+	"github.com/kdisneur/changelog/pkg/filter"
+	"github.com/kdisneur/changelog/pkg/git"
+	"github.com/kdisneur/changelog/pkg/parser"
+)
+
+type shippedChanges struct {
+	references map[parser.Reference]git.Reference
+	commits    map[string]git.Reference
+}
+
+func findReferences(conf *configuration.ValidatedConfig, commit *git.Commit) []parser.Reference {
+	references := conf.CommitParser.FindReferences(commit.Message)
+	if len(references) > 0 {
+		return references
+	}
+
+	originalID, isCherryPick := commit.CherryPickedFrom()
+	if !isCherryPick {
+		return nil
+	}
+
+	original, err := conf.Repository.FindCommit(originalID)
+	if err != nil {
+		return nil
+	}
+
+	return conf.CommitParser.FindReferences(original.Message)
+}
+
+func findShippedChanges(conf *configuration.ValidatedConfig) (*shippedChanges, error) {
+	shipped := &shippedChanges{
+		references: make(map[parser.Reference]git.Reference),
+		commits:    make(map[string]git.Reference),
+	}
+
+	for _, shippedIn := range conf.ShippedIn {
+		commits, err := conf.Repository.Log(conf.From, shippedIn)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, commit := range commits {
+			shipped.commits[commit.ID] = shippedIn
+			if originalID, isCherryPick := commit.CherryPickedFrom(); isCherryPick {
+				shipped.commits[originalID] = shippedIn
+			}
+
+			for _, reference := range findReferences(conf, commit) {
+				shipped.references[reference] = shippedIn
+			}
+		}
+	}
+
+	return shipped, nil
+}
+
+func (s *shippedChanges) excludeIssues(collected *collection) ([]*bugtracker.Issue, []*filter.Exclusion) {
+	var kept []*bugtracker.Issue
+	var excluded []*filter.Exclusion
+
+	for _, issue := range collected.issues {
+		shippedIn, isShipped := s.findIssue(collected.references[issue])
+		if isShipped {
+			excluded = append(excluded, &filter.Exclusion{Issue: issue, Reason: fmt.Sprintf("already shipped in %s", shippedIn)})
+			continue
+		}
+
+		kept = append(kept, issue)
+	}
+
+	return kept, excluded
+}
+
+func (s *shippedChanges) excludeCommits(commits []*git.Commit) []*git.Commit {
+	var kept []*git.Commit
+
+	for _, commit := range commits {
+		if _, isShipped := s.commits[commit.ID]; !isShipped {
+			kept = append(kept, commit)
+		}
+	}
+
+	return kept
+}
+
+func (s *shippedChanges) findIssue(references []parser.Reference) (git.Reference, bool) {
+	for _, reference := range references {
+		if shippedIn, isShipped := s.references[reference]; isShipped {
+			return shippedIn, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,106 @@
+package changelog
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/kdisneur/changelog/pkg/bugtracker"
+	"github.com/kdisneur/changelog/pkg/configuration"
+	"github.com/kdisneur/changelog/pkg/git"
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+type contribution struct {
+	commit *git.Commit
+	issue  *bugtracker.Issue
+}
+
+func buildContributors(conf *configuration.ValidatedConfig, previousAuthors []git.Person, contributions []contribution) []*release.Contributor {
+	var contributors []*release.Contributor
+	indexedContributors := make(map[string]*release.Contributor)
+
+	for _, contribution := range contributions {
+		author := contributionAuthor(conf.Repository, contribution.commit)
+		contributor := newContributor(contribution, author)
+
+		if isIgnoredContributor(conf.IgnoredContributors, contributor, author) {
+			continue
+		}
+
+		firstTime := !hasAuthored(previousAuthors, author)
+
+		key := strings.ToLower(contributor.Login)
+		if key == "" {
+			key = strings.ToLower(author.Email)
+		}
+
+		if existingContributor, ok := indexedContributors[key]; ok {
+			existingContributor.FirstTime = existingContributor.FirstTime && firstTime
+			continue
+		}
+
+		contributor.FirstTime = firstTime
+		indexedContributors[key] = contributor
+		contributors = append(contributors, contributor)
+	}
+
+	sort.SliceStable(contributors, func(i, j int) bool {
+		return strings.ToLower(contributorName(contributors[i])) < strings.ToLower(contributorName(contributors[j]))
+	})
+
+	return contributors
+}
+
+func contributionAuthor(repository git.Git, commit *git.Commit) git.Person {
+	if !commit.IsMerge {
+		return commit.Author
+	}
+
+	branchCommits, err := repository.Log(git.Reference(commit.ID+"^1"), git.Reference(commit.ID+"^2"))
+	if err != nil || len(branchCommits) == 0 {
+		return commit.Author
+	}
+
+	return branchCommits[len(branchCommits)-1].Author
+}
+
+func newContributor(contribution contribution, author git.Person) *release.Contributor {
+	if contribution.issue != nil && contribution.issue.Author.Login != "" {
+		return &release.Contributor{
+			Login: contribution.issue.Author.Login,
+			Link:  contribution.issue.Author.Link,
+		}
+	}
+
+	return &release.Contributor{Name: author.Fullname}
+}
+
+func contributorName(contributor *release.Contributor) string {
+	if contributor.Login != "" {
+		return contributor.Login
+	}
+
+	return contributor.Name
+}
+
+func isIgnoredContributor(ignoreList []string, contributor *release.Contributor, author git.Person) bool {
+	for _, ignored := range ignoreList {
+		if strings.EqualFold(ignored, contributor.Login) ||
+			strings.EqualFold(ignored, author.Fullname) ||
+			strings.EqualFold(ignored, author.Email) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasAuthored(authors []git.Person, author git.Person) bool {
+	for _, previousAuthor := range authors {
+		if strings.EqualFold(previousAuthor.Email, author.Email) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,80 @@
+package changelog
+
+import (
+	"github.com/kdisneur/changelog/pkg/configuration"
+	"github.com/kdisneur/changelog/pkg/git"
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+func buildDirectCommits(conf *configuration.ValidatedConfig, commits []*git.Commit, mainline map[string]bool) []*release.Commit {
+	var directCommits []*release.Commit
+
+	for _, commit := range commits {
+		if commit.IsMerge || isIgnoredDirectCommit(conf, commit) {
+			continue
+		}
+
+		if mainline != nil && !mainline[commit.ID] {
+			continue
+		}
+
+		directCommit := &release.Commit{Commit: commit}
+		if conf.Remote != nil {
+			directCommit.Link = conf.Remote.CommitURL(commit.ID)
+		}
+
+		directCommits = append(directCommits, directCommit)
+	}
+
+	return directCommits
+}
+
+func isIgnoredDirectCommit(conf *configuration.ValidatedConfig, commit *git.Commit) bool {
+	for _, pattern := range conf.IgnoredDirectCommits {
+		if pattern.MatchString(commit.Message) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func findMainlineCommits(commits []*git.Commit) map[string]bool {
+	indexedCommits := make(map[string]*git.Commit)
+	parents := make(map[string]bool)
+	hasMerge := false
+
+	for _, commit := range commits {
+		indexedCommits[commit.ID] = commit
+		hasMerge = hasMerge || commit.IsMerge
+
+		for _, parent := range commit.Parents {
+			parents[parent] = true
+		}
+	}
+
+	if !hasMerge || len(parents) == 0 {
+		return nil
+	}
+
+	var head *git.Commit
+	for _, commit := range commits {
+		if !parents[commit.ID] {
+			head = commit
+			break
+		}
+	}
+
+	mainline := make(map[string]bool)
+	for commit := head; commit != nil && !mainline[commit.ID]; {
+		mainline[commit.ID] = true
+
+		if len(commit.Parents) == 0 {
+			break
+		}
+
+		commit = indexedCommits[commit.Parents[0]]
+	}
+
+	return mainline
+}
@@ -0,0 +1,113 @@
+package changelog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kdisneur/changelog/pkg/bugtracker"
+	"github.com/kdisneur/changelog/pkg/configuration"
+	"github.com/kdisneur/changelog/pkg/filter"
+	"github.com/kdisneur/changelog/pkg/git"
+	"github.com/kdisneur/changelog/pkg/parser"
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+type Explanation struct {
+	Commit     *git.Commit
+	Kept       bool
+	References []*ReferenceExplanation
+	Section    string
+}
+
+type ReferenceExplanation struct {
+	Reference parser.Reference
+	Lookup    string
+	issue     *bugtracker.Issue
+}
+
+type explanations struct {
+	list     []*Explanation
+	byCommit map[string]*Explanation
+}
+
+func newExplanations(parser parser.Parser, commits []*git.Commit) *explanations {
+	explained := &explanations{byCommit: make(map[string]*Explanation)}
+
+	for _, commit := range commits {
+		explanation := &Explanation{Commit: commit, Kept: parser.KeepCommit(commit.Message)}
+
+		explained.list = append(explained.list, explanation)
+		explained.byCommit[commit.ID] = explanation
+	}
+
+	return explained
+}
+
+func (e *explanations) addLookup(commit *git.Commit, reference parser.Reference, lookup string, issue *bugtracker.Issue) {
+	explanation, ok := e.byCommit[commit.ID]
+	if !ok {
+		return
+	}
+
+	explanation.References = append(explanation.References, &ReferenceExplanation{Reference: reference, Lookup: lookup, issue: issue})
+}
+
+func (e *explanations) setSection(commits []*git.Commit, section string) {
+	for _, commit := range commits {
+		if explanation, ok := e.byCommit[commit.ID]; ok && explanation.Section == "" {
+			explanation.Section = section
+		}
+	}
+}
+
+func (e *explanations) resolveSections(issues []*bugtracker.Issue, excluded []*filter.Exclusion, directCommits []*release.Commit) {
+	statuses := make(map[string]string)
+	for _, issue := range issues {
+		statuses[issueKey(issue)] = configuration.SectionChanges
+	}
+
+	for _, exclusion := range excluded {
+		statuses[issueKey(exclusion.Issue)] = fmt.Sprintf("excluded (%s)", exclusion.Reason)
+	}
+
+	var otherChanges []*git.Commit
+	for _, directCommit := range directCommits {
+		otherChanges = append(otherChanges, directCommit.Commit)
+	}
+	e.setSection(otherChanges, configuration.SectionOtherChanges)
+
+	for _, explanation := range e.list {
+		if explanation.Section != "" {
+			continue
+		}
+
+		if len(explanation.References) == 0 {
+			explanation.Section = "dropped (no reference)"
+			continue
+		}
+
+		var sections []string
+		seen := make(map[string]bool)
+		for _, reference := range explanation.References {
+			section, ok := statuses[issueKey(reference.issue)]
+			if !ok {
+				section = "dropped"
+			}
+
+			if !seen[section] {
+				seen[section] = true
+				sections = append(sections, section)
+			}
+		}
+
+		explanation.Section = strings.Join(sections, ", ")
+	}
+}
+
+func issueKey(issue *bugtracker.Issue) string {
+	if issue == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s %s", issue.ID, issue.Link)
+}
@@ -0,0 +1,45 @@
+package changelog
+
+import (
+	"github.com/kdisneur/changelog/pkg/configuration"
+)
+
+func BuildHistory(conf *configuration.ValidatedConfig, limit int) ([]*Result, error) {
+	tags, err := conf.Repository.Tags(conf.To)
+	if err != nil {
+		return nil, err
+	}
+
+	start := 0
+	if limit > 0 && len(tags) > limit {
+		start = len(tags) - limit
+	}
+
+	var results []*Result
+	for index := len(tags) - 1; index >= start; index-- {
+		date, err := conf.Repository.TagDate(tags[index])
+		if err != nil {
+			return nil, err
+		}
+
+		tagConf := *conf
+		tagConf.From = ""
+		if index > 0 {
+			tagConf.From = tags[index-1]
+		}
+		tagConf.To = tags[index]
+		tagConf.VersionName = string(tags[index])
+		tagConf.Date = date.In(conf.Date.Location())
+		tagConf.Unreleased = false
+		tagConf.Profiles = nil
+
+		result, err := build(&tagConf, true)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
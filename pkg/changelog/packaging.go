@@ -0,0 +1,40 @@
+package changelog
+
+import (
+	"path"
+
+	"github.com/kdisneur/changelog/pkg/configuration"
+	"github.com/kdisneur/changelog/pkg/git"
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+func buildPackage(conf *configuration.ValidatedConfig, commits []*git.Commit) release.Package {
+	releasePackage := conf.Package
+
+	if releasePackage.Name == "" && conf.Remote != nil {
+		releasePackage.Name = path.Base(conf.Remote.RepositoryName)
+	}
+
+	if releasePackage.Distribution == "" {
+		releasePackage.Distribution = "unstable"
+	}
+
+	if releasePackage.Urgency == "" {
+		releasePackage.Urgency = "medium"
+	}
+
+	if releasePackage.Maintainer.Email == "" {
+		var latest *git.Commit
+		for _, commit := range commits {
+			if latest == nil || commit.CommittedAt.After(latest.CommittedAt) {
+				latest = commit
+			}
+		}
+
+		if latest != nil {
+			releasePackage.Maintainer = latest.Committer
+		}
+	}
+
+	return releasePackage
+}
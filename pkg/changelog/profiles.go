@@ -0,0 +1,164 @@
+package changelog
+
+import (
+	"github.com/kdisneur/changelog/pkg/bugtracker"
+	"github.com/kdisneur/changelog/pkg/configuration"
+	"github.com/kdisneur/changelog/pkg/filter"
+	"github.com/kdisneur/changelog/pkg/git"
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+type walk struct {
+	issues          []*bugtracker.Issue
+	excluded        []*filter.Exclusion
+	directCommits   []*release.Commit
+	revertedIssues  []*bugtracker.Issue
+	contributions   []contribution
+	previousAuthors []git.Person
+	releasePackage  release.Package
+}
+
+func selectedProfiles(conf *configuration.ValidatedConfig) []*configuration.ValidatedProfile {
+	if len(conf.Profiles) > 0 {
+		return conf.Profiles
+	}
+
+	sections := []string{configuration.SectionChanges}
+	if conf.DirectCommits {
+		sections = append(sections, configuration.SectionOtherChanges)
+	}
+
+	if conf.Reverts == configuration.RevertsList {
+		sections = append(sections, configuration.SectionReverted)
+	}
+
+	if conf.Contributors {
+		sections = append(sections, configuration.SectionContributors)
+	}
+
+	return []*configuration.ValidatedProfile{{Formatter: conf.Formatter, Filter: conf.Filter, Sections: sections, Output: conf.Output}}
+}
+
+func needsSection(profiles []*configuration.ValidatedProfile, section string) bool {
+	for _, profile := range profiles {
+		if profile.HasSection(section) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func render(conf *configuration.ValidatedConfig, profile *configuration.ValidatedProfile, walked *walk) *Rendering {
+	issues, filtered := profile.Filter.Apply(walked.issues)
+	excluded := append(append([]*filter.Exclusion{}, walked.excluded...), filtered...)
+
+	if conf.ReleaseNotes != nil {
+		var withoutReleaseNotes []*filter.Exclusion
+
+		issues, withoutReleaseNotes = applyReleaseNotes(conf.ReleaseNotes, issues)
+		excluded = append(excluded, withoutReleaseNotes...)
+	}
+
+	newRelease := &release.Release{
+		VersionName: conf.VersionName,
+		Date:        conf.Date,
+		Unreleased:  conf.Unreleased,
+		CompareLink: compareLink(conf),
+		Package:     walked.releasePackage,
+	}
+
+	if conf.Remote != nil {
+		newRelease.Repository = conf.Remote.WebURL()
+		if !conf.Unreleased {
+			newRelease.Link = conf.Remote.ReleaseURL(conf.VersionName)
+		}
+	}
+
+	if profile.HasSection(configuration.SectionChanges) {
+		newRelease.Issues = issues
+	}
+
+	if profile.HasSection(configuration.SectionOtherChanges) {
+		newRelease.DirectCommits = walked.directCommits
+	}
+
+	if profile.HasSection(configuration.SectionReverted) {
+		newRelease.Reverted = walked.revertedIssues
+	}
+
+	if profile.HasSection(configuration.SectionContributors) {
+		contributions := keptContributions(walked.contributions, excluded)
+		for _, directCommit := range newRelease.DirectCommits {
+			contributions = append(contributions, contribution{commit: directCommit.Commit})
+		}
+
+		newRelease.Contributors = buildContributors(conf, walked.previousAuthors, contributions)
+	}
+
+	if profile.HideLinks {
+		newRelease = withoutLinks(newRelease)
+	}
+
+	return &Rendering{
+		Profile:   profile,
+		Release:   newRelease,
+		Changelog: profile.Formatter.Format(newRelease),
+		Excluded:  excluded,
+		issues:    issues,
+	}
+}
+
+func compareLink(conf *configuration.ValidatedConfig) string {
+	if !conf.CompareLinks || conf.Remote == nil || conf.From == "" {
+		return ""
+	}
+
+	if conf.Unreleased {
+		return conf.Remote.CompareURL(string(conf.From), string(conf.To))
+	}
+
+	return conf.Remote.CompareURL(string(conf.From), conf.VersionName)
+}
+
+func withoutLinks(original *release.Release) *release.Release {
+	stripped := *original
+	stripped.CompareLink = ""
+	stripped.Link = ""
+	stripped.Repository = ""
+	stripped.Issues = withoutIssueLinks(original.Issues)
+	stripped.Reverted = withoutIssueLinks(original.Reverted)
+
+	stripped.DirectCommits = nil
+	for _, directCommit := range original.DirectCommits {
+		stripped.DirectCommits = append(stripped.DirectCommits, &release.Commit{Commit: directCommit.Commit})
+	}
+
+	stripped.Contributors = nil
+	for _, contributor := range original.Contributors {
+		strippedContributor := *contributor
+		strippedContributor.Link = ""
+		stripped.Contributors = append(stripped.Contributors, &strippedContributor)
+	}
+
+	return &stripped
+}
+
+func withoutIssueLinks(issues []*bugtracker.Issue) []*bugtracker.Issue {
+	var stripped []*bugtracker.Issue
+
+	for _, issue := range issues {
+		strippedIssue := *issue
+		strippedIssue.Link = ""
+
+		strippedIssue.LinkedIssues = nil
+		for _, linkedIssue := range issue.LinkedIssues {
+			linkedIssue.Link = ""
+			strippedIssue.LinkedIssues = append(strippedIssue.LinkedIssues, linkedIssue)
+		}
+
+		stripped = append(stripped, &strippedIssue)
+	}
+
+	return stripped
+}
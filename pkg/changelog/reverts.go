@@ -0,0 +1,101 @@
+package changelog
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/kdisneur/changelog/pkg/git"
+)
+
+var revertedCommitRegex = regexp.MustCompile(`(?m)^This reverts commit ([0-9a-f]{7,40})`)
+var revertedTitleRegex = regexp.MustCompile(`^Revert "(.+)"`)
+
+func cancelReverts(commits []*git.Commit) ([]*git.Commit, []*git.Commit, []*git.Commit) {
+	targets := make(map[*git.Commit]*git.Commit)
+	revertedBy := make(map[*git.Commit][]*git.Commit)
+
+	for _, commit := range commits {
+		target, found := findRevertedCommit(commits, commit)
+		if found {
+			targets[commit] = target
+			revertedBy[target] = append(revertedBy[target], commit)
+		}
+	}
+
+	cancelled := make(map[*git.Commit]bool)
+	var isCancelled func(commit *git.Commit, visited map[*git.Commit]bool) bool
+	isCancelled = func(commit *git.Commit, visited map[*git.Commit]bool) bool {
+		if visited[commit] {
+			return false
+		}
+		visited[commit] = true
+
+		for _, revert := range revertedBy[commit] {
+			if !isCancelled(revert, visited) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, commit := range commits {
+		cancelled[commit] = isCancelled(commit, make(map[*git.Commit]bool))
+	}
+
+	var kept []*git.Commit
+	var reverted []*git.Commit
+	var reverts []*git.Commit
+	for _, commit := range commits {
+		_, isRevert := targets[commit]
+
+		switch {
+		case isRevert:
+			reverts = append(reverts, commit)
+		case cancelled[commit]:
+			reverted = append(reverted, commit)
+		default:
+			kept = append(kept, commit)
+		}
+	}
+
+	return kept, reverted, reverts
+}
+
+func findRevertedCommit(commits []*git.Commit, revert *git.Commit) (*git.Commit, bool) {
+	for _, matches := range revertedCommitRegex.FindAllStringSubmatch(revert.Body, -1) {
+		for _, commit := range commits {
+			if commit != revert && strings.HasPrefix(commit.ID, matches[1]) {
+				return commit, true
+			}
+		}
+	}
+
+	title, found := revertedTitle(revert)
+	if !found {
+		return nil, false
+	}
+
+	for _, commit := range commits {
+		if commit != revert && (commit.Message == title || firstLine(commit.Body) == title) {
+			return commit, true
+		}
+	}
+
+	return nil, false
+}
+
+func revertedTitle(commit *git.Commit) (string, bool) {
+	for _, line := range []string{commit.Message, firstLine(commit.Body)} {
+		matches := revertedTitleRegex.FindStringSubmatch(line)
+		if len(matches) == 2 {
+			return matches[1], true
+		}
+	}
+
+	return "", false
+}
+
+func firstLine(text string) string {
+	return strings.SplitN(text, "\n", 2)[0]
+}
@@ -0,0 +1,91 @@
+package changelogfile
+
+import (
+	"strings"
+)
+
+const headingPrefix = "## "
+const unreleasedName = "Unreleased"
+
+func Update(existing string, section string) string {
+	section = strings.TrimRight(section, "\n") + "\n"
+	lines := strings.SplitAfter(existing, "\n")
+
+	var headings []int
+	for index, line := range lines {
+		if strings.HasPrefix(line, headingPrefix) {
+			headings = append(headings, index)
+		}
+	}
+
+	name := versionName(firstLine(section))
+	target := -1
+	unreleased := -1
+	for _, index := range headings {
+		headingName := versionName(lines[index])
+
+		if target == -1 && strings.EqualFold(headingName, name) {
+			target = index
+		}
+
+		if unreleased == -1 && strings.EqualFold(headingName, unreleasedName) {
+			unreleased = index
+		}
+	}
+
+	if target == -1 {
+		target = unreleased
+	}
+
+	if target == -1 {
+		insertAt := len(lines)
+		if len(headings) > 0 {
+			insertAt = headings[0]
+		}
+
+		return join(separate(strings.Join(lines[:insertAt], "")), section, strings.Join(lines[insertAt:], ""))
+	}
+
+	end := len(lines)
+	for _, index := range headings {
+		if index > target {
+			end = index
+			break
+		}
+	}
+
+	return join(strings.Join(lines[:target], ""), section, strings.Join(lines[end:], ""))
+}
+
+func versionName(heading string) string {
+	name := strings.TrimSpace(strings.TrimPrefix(heading, headingPrefix))
+
+	if end := strings.Index(name, "]"); strings.HasPrefix(name, "[") && end != -1 {
+		return name[1:end]
+	}
+
+	return strings.SplitN(name, " - ", 2)[0]
+}
+
+func separate(before string) string {
+	switch {
+	case before == "" || strings.HasSuffix(before, "\n\n"):
+		return before
+	case strings.HasSuffix(before, "\n"):
+		return before + "\n"
+	default:
+		return before + "\n\n"
+	}
+}
+
+func join(before string, section string, after string) string {
+	if after == "" {
+		return before + section
+	}
+
+	return before + section + "\n" + after
+}
+
+func firstLine(text string) string {
+	return strings.SplitN(text, "\n", 2)[0]
+}
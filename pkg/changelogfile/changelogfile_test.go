@@ -0,0 +1,86 @@
+package changelogfile_test
+
+import (
+	"testing"
+
+	"github.com/kdisneur/changelog/pkg/changelogfile"
+)
+
+func TestUpdate(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Existing string
+		Section  string
+		Expected string
+	}{
+		{
+			"When the file is empty",
+			"",
+			"## [Unreleased]\n\n- Add login (#42)\n",
+			"## [Unreleased]\n\n- Add login (#42)\n",
+		},
+		{
+			"When the file only has a title",
+			"# Changelog",
+			"## [Unreleased]\n\n- Add login (#42)\n",
+			"# Changelog\n\n## [Unreleased]\n\n- Add login (#42)\n",
+		},
+		{
+			"When the file has no unreleased section",
+			"# Changelog\n\n## v1.0.0 - 2018-11-21\n\n- Initial release (#1)\n",
+			"## [Unreleased]\n\n- Add login ([#42])\n\n[#42]: https://github.com/acme/widgets/pull/42\n",
+			"# Changelog\n\n## [Unreleased]\n\n- Add login ([#42])\n\n[#42]: https://github.com/acme/widgets/pull/42\n\n## v1.0.0 - 2018-11-21\n\n- Initial release (#1)\n",
+		},
+		{
+			"When the file has an unreleased section",
+			"# Changelog\n\n## [Unreleased]\n\n- Add login (#42)\n\n## v1.0.0 - 2018-11-21\n\n- Initial release (#1)\n",
+			"## [Unreleased]\n\n- Add login (#42)\n- Add logout (#43)\n",
+			"# Changelog\n\n## [Unreleased]\n\n- Add login (#42)\n- Add logout (#43)\n\n## v1.0.0 - 2018-11-21\n\n- Initial release (#1)\n",
+		},
+		{
+			"When the unreleased section is the last one",
+			"# Changelog\n\n## [Unreleased]\n\n- Add login (#42)\n",
+			"## [Unreleased]\n\n(No changes)\n",
+			"# Changelog\n\n## [Unreleased]\n\n(No changes)\n",
+		},
+		{
+			"When a version is released over the unreleased section",
+			"# Changelog\n\n## [Unreleased]\n\n- Add login (#42)\n\n## v1.0.0 - 2018-11-21\n\n- Initial release (#1)\n",
+			"## v1.1.0 - 2018-12-01\n\n- Add login (#42)\n",
+			"# Changelog\n\n## v1.1.0 - 2018-12-01\n\n- Add login (#42)\n\n## v1.0.0 - 2018-11-21\n\n- Initial release (#1)\n",
+		},
+		{
+			"When the version already has a section",
+			"# Changelog\n\n## [Unreleased]\n\n- Add logout (#43)\n\n## [v1.0.0] - 2018-11-20\n\n- Initial release (#1)\n",
+			"## v1.0.0 - 2018-11-21\n\n- Initial release (#1)\n- Fix login (#2)\n",
+			"# Changelog\n\n## [Unreleased]\n\n- Add logout (#43)\n\n## v1.0.0 - 2018-11-21\n\n- Initial release (#1)\n- Fix login (#2)\n",
+		},
+		{
+			"When the linked unreleased section compares from an older tag",
+			"# Changelog\n\n## [Unreleased](https://github.com/acme/widgets/compare/v1.0.0...master)\n\n- Add login (#42)\n\n## [v1.0.0](https://github.com/acme/widgets/compare/v0.9.0...v1.0.0) - 2018-11-21\n\n- Initial release (#1)\n",
+			"## [Unreleased](https://github.com/acme/widgets/compare/v1.1.0...master)\n\n- Add logout (#43)\n",
+			"# Changelog\n\n## [Unreleased](https://github.com/acme/widgets/compare/v1.1.0...master)\n\n- Add logout (#43)\n\n## [v1.0.0](https://github.com/acme/widgets/compare/v0.9.0...v1.0.0) - 2018-11-21\n\n- Initial release (#1)\n",
+		},
+		{
+			"When a linked version is released over the linked unreleased section",
+			"# Changelog\n\n## [Unreleased](https://github.com/acme/widgets/compare/v1.0.0...master)\n\n- Add login (#42)\n\n## [v1.0.0](https://github.com/acme/widgets/compare/v0.9.0...v1.0.0) - 2018-11-21\n\n- Initial release (#1)\n",
+			"## [v1.1.0](https://github.com/acme/widgets/compare/v1.0.0...v1.1.0) - 2018-12-01\n\n- Add login (#42)\n",
+			"# Changelog\n\n## [v1.1.0](https://github.com/acme/widgets/compare/v1.0.0...v1.1.0) - 2018-12-01\n\n- Add login (#42)\n\n## [v1.0.0](https://github.com/acme/widgets/compare/v0.9.0...v1.0.0) - 2018-11-21\n\n- Initial release (#1)\n",
+		},
+		{
+			"When a linked version already has a section",
+			"# Changelog\n\n## [v1.1.0](https://github.com/acme/widgets/compare/v1.0.0...v1.1.0) - 2018-12-01\n\n- Add login (#42)\n\n## v1.0.0 - 2018-11-21\n\n- Initial release (#1)\n",
+			"## [v1.1.0](https://github.com/acme/widgets/compare/v1.0.0...v1.1.0) - 2018-12-02\n\n- Add login (#42)\n- Add logout (#43)\n",
+			"# Changelog\n\n## [v1.1.0](https://github.com/acme/widgets/compare/v1.0.0...v1.1.0) - 2018-12-02\n\n- Add login (#42)\n- Add logout (#43)\n\n## v1.0.0 - 2018-11-21\n\n- Initial release (#1)\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			actual := changelogfile.Update(testCase.Existing, testCase.Section)
+			if actual != testCase.Expected {
+				t.Fatalf("Wrong changelog.\nExpected:\n%s\nReceived:\n%s", testCase.Expected, actual)
+			}
+		})
+	}
+}
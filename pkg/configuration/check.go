@@ -0,0 +1,208 @@
+package configuration
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+func UnknownKeys(settings map[string]interface{}) []string {
+	var unknown []string
+
+	collectUnknownKeys(reflect.TypeOf(File{}), settings, "", &unknown)
+	sort.Strings(unknown)
+
+	return unknown
+}
+
+func CheckLocalFile(settings map[string]interface{}) []error {
+	var problems []error
+
+	if github, ok := settings["github"].(map[string]interface{}); ok {
+		var keys []string
+		for key := range github {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			problems = append(problems, fmt.Errorf("[github] %s: can't be set in the repository file, keep it in the user file", key))
+		}
+	}
+
+	profiles, _ := settings["profile"].(map[string]interface{})
+
+	var names []string
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		profile, _ := profiles[name].(map[string]interface{})
+		output, _ := profile["output"].(string)
+
+		if isOutsidePath(output) {
+			problems = append(problems, fmt.Errorf("[profile.%s] output: '%s' must be a relative path inside the repository", name, output))
+		}
+	}
+
+	return problems
+}
+
+func isOutsidePath(path string) bool {
+	cleaned := filepath.Clean(path)
+
+	return filepath.IsAbs(path) || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator))
+}
+
+func Keys() []string {
+	var keys []string
+
+	collectKeys(reflect.TypeOf(File{}), "", &keys)
+	sort.Strings(keys)
+
+	return keys
+}
+
+func collectKeys(schema reflect.Type, path string, keys *[]string) {
+	for index := 0; index < schema.NumField(); index++ {
+		field := schema.Field(index)
+		key := joinKey(path, strings.ToLower(field.Name))
+
+		switch {
+		case field.Type.Kind() == reflect.Struct:
+			collectKeys(field.Type, key, keys)
+		case field.Type.Kind() == reflect.Map, field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() == reflect.Struct:
+			continue
+		default:
+			*keys = append(*keys, key)
+		}
+	}
+}
+
+func collectUnknownKeys(schema reflect.Type, value interface{}, path string, unknown *[]string) {
+	switch schema.Kind() {
+	case reflect.Struct:
+		values, ok := value.(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		for key, nested := range values {
+			field, found := findField(schema, key)
+			if !found {
+				*unknown = append(*unknown, joinKey(path, key))
+				continue
+			}
+
+			collectUnknownKeys(field.Type, nested, joinKey(path, key), unknown)
+		}
+	case reflect.Map:
+		values, ok := value.(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		for key, nested := range values {
+			collectUnknownKeys(schema.Elem(), nested, joinKey(path, key), unknown)
+		}
+	case reflect.Slice:
+		items := reflect.ValueOf(value)
+		if schema.Elem().Kind() != reflect.Struct || items.Kind() != reflect.Slice {
+			return
+		}
+
+		for index := 0; index < items.Len(); index++ {
+			collectUnknownKeys(schema.Elem(), items.Index(index).Interface(), fmt.Sprintf("%s[%d]", path, index), unknown)
+		}
+	}
+}
+
+func findField(schema reflect.Type, key string) (reflect.StructField, bool) {
+	for index := 0; index < schema.NumField(); index++ {
+		field := schema.Field(index)
+		if strings.EqualFold(field.Name, key) {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+func joinKey(path string, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return fmt.Sprintf("%s.%s", path, key)
+}
+
+func Check(file File) []error {
+	var problems []error
+
+	if file.General.MergeStrategy != "" {
+		if _, err := getCommitParser(file, Command{MergeStrategy: file.General.MergeStrategy}, ""); err != nil {
+			problems = append(problems, fmt.Errorf("[general] mergeStrategy: %s", err.Error()))
+		}
+	}
+
+	if _, err := getFormatter(file, Command{}); err != nil {
+		problems = append(problems, fmt.Errorf("[general] format: %s", err.Error()))
+	}
+
+	if _, err := getLocation(file, Command{}); err != nil {
+		problems = append(problems, fmt.Errorf("[general] timezone: %s", err.Error()))
+	}
+
+	if _, err := getReverts(file, Command{}); err != nil {
+		problems = append(problems, fmt.Errorf("[general] reverts: %s", err.Error()))
+	}
+
+	if _, err := getFilter(file, ""); err != nil {
+		problems = append(problems, fmt.Errorf("[general] filters: %s", err.Error()))
+	}
+
+	if _, err := getPackage(file); err != nil {
+		problems = append(problems, fmt.Errorf("[packaging] %s", err.Error()))
+	}
+
+	if _, err := getIgnoredDirectCommits(file); err != nil {
+		problems = append(problems, fmt.Errorf("[directCommits] ignore: %s", err.Error()))
+	}
+
+	for _, customParser := range file.Parser {
+		if _, err := getStrategyParser(file, customParser.Name); err != nil {
+			problems = append(problems, fmt.Errorf("[[parser]] '%s': %s", customParser.Name, err.Error()))
+		}
+	}
+
+	for _, name := range file.ProfileNames() {
+		if _, err := getProfile(file, Command{}, "", name); err != nil {
+			problems = append(problems, err)
+		}
+	}
+
+	for _, repository := range file.Repository {
+		if repository.Name == "" {
+			problems = append(problems, fmt.Errorf("[[repository]] without name"))
+			continue
+		}
+
+		if repository.MergeStrategy != "" {
+			if _, err := getCommitParser(file, Command{MergeStrategy: repository.MergeStrategy}, repository.Name); err != nil {
+				problems = append(problems, fmt.Errorf("[[repository]] '%s' mergeStrategy: %s", repository.Name, err.Error()))
+			}
+		}
+
+		for _, rules := range []Filter{repository.Include, repository.Exclude} {
+			if _, err := newFilterRules(rules); err != nil {
+				problems = append(problems, fmt.Errorf("[[repository]] '%s' filters: %s", repository.Name, err.Error()))
+			}
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,188 @@
+package configuration_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kdisneur/changelog/pkg/configuration"
+)
+
+func TestUnknownKeys(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Settings map[string]interface{}
+		Expected []string
+	}{
+		{
+			"When every key is known",
+			map[string]interface{}{
+				"general": map[string]interface{}{"mergestrategy": "squash", "include": map[string]interface{}{"labels": []interface{}{"feature"}}},
+				"github":  map[string]interface{}{"token": "aaaa"},
+				"repository": []map[string]interface{}{
+					{"name": "kdisneur/changelog", "basebranch": "main"},
+				},
+			},
+			nil,
+		},
+		{
+			"When some keys are unknown",
+			map[string]interface{}{
+				"general": map[string]interface{}{"mergestratgy": "squash", "include": map[string]interface{}{"label": []interface{}{"feature"}}},
+				"gitlab":  map[string]interface{}{"token": "aaaa"},
+				"profile": map[string]interface{}{
+					"public": map[string]interface{}{"format": "json", "hidelink": true},
+				},
+				"parser": []interface{}{
+					map[string]interface{}{"name": "jira", "regex": "[A-Z]+-[0-9]+"},
+					map[string]interface{}{"name": "linear", "regexp": "LIN-[0-9]+"},
+				},
+			},
+			[]string{"general.include.label", "general.mergestratgy", "gitlab", "parser[1].regexp", "profile.public.hidelink"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			unknown := configuration.UnknownKeys(testCase.Settings)
+
+			if strings.Join(unknown, ",") != strings.Join(testCase.Expected, ",") {
+				t.Errorf("Wrong unknown keys.\nExpected: %v\nReceived: %v", testCase.Expected, unknown)
+			}
+		})
+	}
+}
+
+func TestCheckLocalFile(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Settings map[string]interface{}
+		Expected []string
+	}{
+		{
+			"When the repository file is valid",
+			map[string]interface{}{
+				"general": map[string]interface{}{"mergestrategy": "squash"},
+				"github":  map[string]interface{}{},
+				"profile": map[string]interface{}{
+					"public": map[string]interface{}{"format": "markdown", "output": "docs/CHANGELOG.md"},
+				},
+			},
+			nil,
+		},
+		{
+			"When the repository file overrides the github settings",
+			map[string]interface{}{
+				"github": map[string]interface{}{"token": "aaaa", "apiurl": "http://127.0.0.1:18765"},
+			},
+			[]string{
+				"[github] apiurl: can't be set in the repository file, keep it in the user file",
+				"[github] token: can't be set in the repository file, keep it in the user file",
+			},
+		},
+		{
+			"When the repository file writes outside of the repository",
+			map[string]interface{}{
+				"profile": map[string]interface{}{
+					"absolute": map[string]interface{}{"output": "/etc/profile"},
+					"parent":   map[string]interface{}{"output": "docs/../../CHANGELOG.md"},
+					"sibling":  map[string]interface{}{"output": "..CHANGELOG.md"},
+				},
+			},
+			[]string{
+				"[profile.absolute] output: '/etc/profile' must be a relative path inside the repository",
+				"[profile.parent] output: 'docs/../../CHANGELOG.md' must be a relative path inside the repository",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var problems []string
+			for _, problem := range configuration.CheckLocalFile(testCase.Settings) {
+				problems = append(problems, problem.Error())
+			}
+
+			if strings.Join(problems, "\n") != strings.Join(testCase.Expected, "\n") {
+				t.Errorf("Wrong problems.\nExpected: %v\nReceived: %v", testCase.Expected, problems)
+			}
+		})
+	}
+}
+
+func TestKeys(t *testing.T) {
+	keys := strings.Join(configuration.Keys(), ",")
+
+	for _, expected := range []string{"general.basebranch", "general.include.labels", "github.apiurl", "github.token"} {
+		if !strings.Contains(keys, expected) {
+			t.Errorf("Expected keys to contain '%s'.\nReceived: %s", expected, keys)
+		}
+	}
+
+	for _, unexpected := range []string{"parser", "profile", "repository"} {
+		if strings.Contains(keys, unexpected) {
+			t.Errorf("Expected keys not to contain '%s'.\nReceived: %s", unexpected, keys)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		File     configuration.File
+		Expected []string
+	}{
+		{
+			"When the file is valid",
+			configuration.File{
+				General:    configuration.General{MergeStrategy: "squash,jira", Reverts: "list"},
+				Parser:     []configuration.CommitParser{{Name: "jira", Regex: `(?P<id>[A-Z]+-[0-9]+)`}},
+				Repository: []configuration.GitRepository{{Name: "kdisneur/changelog", MergeStrategy: "merge"}},
+			},
+			nil,
+		},
+		{
+			"When the file has invalid values",
+			configuration.File{
+				General:       configuration.General{MergeStrategy: "rebase", Reverts: "drop", Format: "yaml"},
+				DirectCommits: configuration.DirectCommits{Ignore: []string{"(chore"}},
+				Packaging:     configuration.Packaging{Urgency: "urgent"},
+				Repository: []configuration.GitRepository{
+					{Name: "kdisneur/changelog", MergeStrategy: "squash,jira", Exclude: configuration.Filter{Titles: []string{"[wip"}}},
+					{MergeStrategy: "merge"},
+				},
+				Profile: map[string]configuration.Profile{
+					"public":   {Format: "markdown", Sections: []string{"security"}},
+					"internal": {Format: "yaml"},
+				},
+			},
+			[]string{
+				"[general] mergeStrategy: Asked for 'rebase' strategy but support only 'squash', 'merge'",
+				"[general] format: unknown format 'yaml'",
+				"[general] reverts: Asked for 'drop' reverts but support only 'cancel', 'list' or 'keep'",
+				"[packaging] Asked for 'urgent' urgency but support only",
+				"[directCommits] ignore: can't compile direct commit ignore pattern '(chore'",
+				"profile 'internal': unknown format 'yaml'",
+				"profile 'public': unknown section 'security'",
+				"[[repository]] 'kdisneur/changelog' mergeStrategy: Asked for 'jira' strategy but support only 'squash', 'merge'",
+				"[[repository]] 'kdisneur/changelog' filters: can't compile title pattern '[wip'",
+				"[[repository]] without name",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			problems := configuration.Check(testCase.File)
+
+			if len(problems) != len(testCase.Expected) {
+				t.Fatalf("Wrong number of problems.\nExpected: %v\nReceived: %v", testCase.Expected, problems)
+			}
+
+			for index, problem := range problems {
+				if !strings.HasPrefix(problem.Error(), testCase.Expected[index]) {
+					t.Errorf("Wrong problem.\nExpected to start with: %s\nReceived: %s", testCase.Expected[index], problem.Error())
+				}
+			}
+		})
+	}
+}
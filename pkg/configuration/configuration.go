@@ -2,14 +2,22 @@ package configuration
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/kdisneur/changelog/pkg/filter"
 	"github.com/kdisneur/changelog/pkg/formatter"
 	"github.com/kdisneur/changelog/pkg/git"
 	"github.com/kdisneur/changelog/pkg/git/system"
 	"github.com/kdisneur/changelog/pkg/github"
 	"github.com/kdisneur/changelog/pkg/parser"
+	"github.com/kdisneur/changelog/pkg/release"
+	"github.com/kdisneur/changelog/pkg/releasenote"
 )
 
+var maintainerRegex = regexp.MustCompile(`^\s*(.+?)\s*<([^<>\s]+@[^<>\s]+)>\s*$`)
+
 func Validate(file File, command Command) (*ValidatedConfig, error) {
 	repository, err := system.NewRepository(command.RepositoryLocalPath)
 	if err != nil {
@@ -21,30 +29,343 @@ func Validate(file File, command Command) (*ValidatedConfig, error) {
 		return nil, err
 	}
 
-	fromReference := git.NewReference(command.From)
 	toReference := getToReference(file, command, repositoryName)
+	fromReference, err := getFromReference(repository, command, toReference)
+	if err != nil {
+		return nil, err
+	}
+
+	date, err := getDate(repository, file, command, toReference)
+	if err != nil {
+		return nil, err
+	}
 
 	commitParser, err := getCommitParser(file, command, repositoryName)
 	if err != nil {
 		return nil, err
 	}
 
-	formatter := formatter.NewMarkdownFormatter()
+	issueFilter, err := getFilter(file, repositoryName)
+	if err != nil {
+		return nil, err
+	}
+
+	ignoredDirectCommits, err := getIgnoredDirectCommits(file)
+	if err != nil {
+		return nil, err
+	}
+
+	reverts, err := getReverts(file, command)
+	if err != nil {
+		return nil, err
+	}
+
+	formatter, err := getFormatter(file, command)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, err := getProfiles(file, command, repositoryName)
+	if err != nil {
+		return nil, err
+	}
+
+	releasePackage, err := getPackage(file)
+	if err != nil {
+		return nil, err
+	}
 
-	tracker := github.NewBugTracker(file.Github.Token, repositoryName)
+	tracker := github.NewBugTrackerWithOptions(getToken(file, command), getAPIURL(file, command), repositoryName, github.Options{
+		LinkedIssues: command.LinkedIssues || file.Github.LinkedIssues,
+	})
 
 	return &ValidatedConfig{
-		From:         fromReference,
-		To:           toReference,
-		VersionName:  command.VersionName,
-		Date:         command.Date,
-		CommitParser: commitParser,
-		Formatter:    formatter,
-		Repository:   repository,
-		BugTracker:   tracker,
+		From:                 fromReference,
+		To:                   toReference,
+		VersionName:          getVersionName(command),
+		Date:                 date,
+		CommitParser:         commitParser,
+		Formatter:            formatter,
+		Repository:           repository,
+		Remote:               getRemote(repository, repositoryName),
+		BugTracker:           tracker,
+		Filter:               issueFilter,
+		Contributors:         command.Contributors || file.Contributors.Enabled,
+		IgnoredContributors:  file.Contributors.Ignore,
+		ReleaseNotes:         getReleaseNotesExtractor(file, command),
+		Tolerant:             command.Tolerant || file.General.Tolerant,
+		DirectCommits:        command.DirectCommits || file.DirectCommits.Enabled,
+		IgnoredDirectCommits: ignoredDirectCommits,
+		Reverts:              reverts,
+		ShippedIn:            getShippedIn(command),
+		Profiles:             profiles,
+		Unreleased:           command.Unreleased,
+		Output:               command.Output,
+		CompareLinks:         command.CompareLinks || file.General.CompareLinks,
+		Package:              releasePackage,
 	}, nil
 }
 
+func getDate(repository git.Git, file File, command Command, toReference git.Reference) (time.Time, error) {
+	location, err := getLocation(file, command)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	switch command.DateSource {
+	case "":
+		return command.Date.In(location), nil
+	case DateFromTag:
+		date, err := repository.TagDate(toReference)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		return date.In(location), nil
+	case DateFromCommit:
+		commit, err := repository.FindCommit(string(toReference))
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		return commit.CommittedAt.In(location), nil
+	}
+
+	date, err := time.ParseInLocation("2006-01-02", command.DateSource, location)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("can't parse date '%s', expected YYYY-MM-DD, '%s' or '%s'", command.DateSource, DateFromTag, DateFromCommit)
+	}
+
+	return date, nil
+}
+
+func getLocation(file File, command Command) (*time.Location, error) {
+	timezone := command.Timezone
+	if timezone == "" {
+		timezone = file.General.Timezone
+	}
+
+	if timezone == "" {
+		return time.Local, nil
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone '%s': %s", timezone, err.Error())
+	}
+
+	return location, nil
+}
+
+func getFormatter(file File, command Command) (formatter.Formatter, error) {
+	format := "markdown"
+
+	if command.Format != "" {
+		format = command.Format
+	} else if file.General.Format != "" {
+		format = file.General.Format
+	}
+
+	return formatter.New(format)
+}
+
+func getProfiles(file File, command Command, repositoryName string) ([]*ValidatedProfile, error) {
+	var profiles []*ValidatedProfile
+
+	for _, name := range command.Profiles {
+		profile, err := getProfile(file, command, repositoryName, name)
+		if err != nil {
+			return nil, err
+		}
+
+		profiles = append(profiles, profile)
+	}
+
+	return profiles, nil
+}
+
+func getProfile(file File, command Command, repositoryName string, name string) (*ValidatedProfile, error) {
+	profile, ok := file.FindProfile(name)
+	if !ok && len(file.Profile) == 0 {
+		return nil, fmt.Errorf("Asked for '%s' profile but no [profile] is defined", name)
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("Asked for '%s' profile but support only %s", name, strings.Join(supportedProfiles(file), ", "))
+	}
+
+	format := profile.Format
+	if format == "" {
+		format = command.Format
+	}
+
+	formatter, err := getFormatter(file, Command{Format: format})
+	if err != nil {
+		return nil, fmt.Errorf("profile '%s': %s", name, err.Error())
+	}
+
+	issueFilter, err := getFilter(file, repositoryName)
+	if err != nil {
+		return nil, err
+	}
+
+	include, err := newFilterRules(profile.Include)
+	if err != nil {
+		return nil, fmt.Errorf("profile '%s': %s", name, err.Error())
+	}
+
+	exclude, err := newFilterRules(profile.Exclude)
+	if err != nil {
+		return nil, fmt.Errorf("profile '%s': %s", name, err.Error())
+	}
+
+	sections := profile.Sections
+	if len(sections) == 0 {
+		sections = defaultSections(file, command)
+	}
+
+	for _, section := range sections {
+		switch section {
+		case SectionChanges, SectionOtherChanges, SectionReverted, SectionContributors:
+			continue
+		}
+
+		return nil, fmt.Errorf("profile '%s': unknown section '%s', expected '%s', '%s', '%s' or '%s'", name, section, SectionChanges, SectionOtherChanges, SectionReverted, SectionContributors)
+	}
+
+	return &ValidatedProfile{
+		Name:      name,
+		Formatter: formatter,
+		Filter:    filter.Filter{Include: issueFilter.Include.Merge(include), Exclude: issueFilter.Exclude.Merge(exclude)},
+		Sections:  sections,
+		HideLinks: profile.HideLinks,
+		Output:    profile.Output,
+	}, nil
+}
+
+func defaultSections(file File, command Command) []string {
+	sections := []string{SectionChanges}
+
+	if command.DirectCommits || file.DirectCommits.Enabled {
+		sections = append(sections, SectionOtherChanges)
+	}
+
+	if reverts, _ := getReverts(file, command); reverts == RevertsList {
+		sections = append(sections, SectionReverted)
+	}
+
+	if command.Contributors || file.Contributors.Enabled {
+		sections = append(sections, SectionContributors)
+	}
+
+	return sections
+}
+
+func supportedProfiles(file File) []string {
+	var profiles []string
+
+	for _, name := range file.ProfileNames() {
+		profiles = append(profiles, fmt.Sprintf("'%s'", name))
+	}
+
+	return profiles
+}
+
+func getToken(file File, command Command) string {
+	if command.Token != "" {
+		return command.Token
+	}
+
+	return file.Github.Token
+}
+
+func getAPIURL(file File, command Command) string {
+	if command.APIURL != "" {
+		return command.APIURL
+	}
+
+	if file.Github.APIURL != "" {
+		return file.Github.APIURL
+	}
+
+	return github.DefaultAPIURL
+}
+
+func getShippedIn(command Command) []git.Reference {
+	var references []git.Reference
+
+	for _, shippedIn := range command.ShippedIn {
+		references = append(references, git.NewReference(shippedIn))
+	}
+
+	return references
+}
+
+func getReverts(file File, command Command) (string, error) {
+	reverts := RevertsCancel
+
+	if command.Reverts != "" {
+		reverts = command.Reverts
+	} else if file.General.Reverts != "" {
+		reverts = file.General.Reverts
+	}
+
+	switch reverts {
+	case RevertsCancel, RevertsList, RevertsKeep:
+		return reverts, nil
+	}
+
+	return "", fmt.Errorf("Asked for '%s' reverts but support only '%s', '%s' or '%s'", reverts, RevertsCancel, RevertsList, RevertsKeep)
+}
+
+func getPackage(file File) (release.Package, error) {
+	releasePackage := release.Package{
+		Name:         file.Packaging.Name,
+		Distribution: file.Packaging.Distribution,
+		Urgency:      file.Packaging.Urgency,
+	}
+
+	switch releasePackage.Urgency {
+	case "", "low", "medium", "high", "emergency", "critical":
+	default:
+		return release.Package{}, fmt.Errorf("Asked for '%s' urgency but support only 'low', 'medium', 'high', 'emergency' or 'critical'", releasePackage.Urgency)
+	}
+
+	if file.Packaging.Maintainer != "" {
+		matches := maintainerRegex.FindStringSubmatch(file.Packaging.Maintainer)
+		if len(matches) != 3 {
+			return release.Package{}, fmt.Errorf("can't parse maintainer '%s', expected 'Full Name <email>'", file.Packaging.Maintainer)
+		}
+
+		releasePackage.Maintainer = git.Person{Fullname: matches[1], Email: matches[2]}
+	}
+
+	return releasePackage, nil
+}
+
+func getIgnoredDirectCommits(file File) ([]*regexp.Regexp, error) {
+	var patterns []*regexp.Regexp
+
+	for _, ignore := range file.DirectCommits.Ignore {
+		pattern, err := regexp.Compile(ignore)
+		if err != nil {
+			return nil, fmt.Errorf("can't compile direct commit ignore pattern '%s': %s", ignore, err.Error())
+		}
+
+		patterns = append(patterns, pattern)
+	}
+
+	return patterns, nil
+}
+
+func getReleaseNotesExtractor(file File, command Command) *releasenote.Extractor {
+	if !command.ReleaseNotes && !file.ReleaseNotes.Enabled {
+		return nil
+	}
+
+	return releasenote.NewExtractor(file.ReleaseNotes.Heading, file.ReleaseNotes.Marker)
+}
+
 func getCommitParser(file File, command Command, repositoryName string) (parser.Parser, error) {
 	strategy := "squash"
 
@@ -59,14 +380,98 @@ func getCommitParser(file File, command Command, repositoryName string) (parser.
 		}
 	}
 
+	strategies := strings.Split(strategy, ",")
+	if len(strategies) == 1 {
+		return getStrategyParser(file, strategy)
+	}
+
+	var parsers []parser.Parser
+	for _, name := range strategies {
+		strategyParser, err := getStrategyParser(file, strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+
+		parsers = append(parsers, strategyParser)
+	}
+
+	return parser.NewChainParser(parsers...), nil
+}
+
+func getStrategyParser(file File, strategy string) (parser.Parser, error) {
 	switch strategy {
 	case "squash":
 		return github.NewSquashParser(), nil
 	case "merge":
 		return github.NewMergeParser(), nil
-	default:
-		return nil, fmt.Errorf("Asked for '%s' strategy but support only 'squash' and 'merge'", strategy)
 	}
+
+	customParser, ok := file.FindParser(strategy)
+	if !ok {
+		return nil, fmt.Errorf("Asked for '%s' strategy but support only %s", strategy, strings.Join(supportedStrategies(file), ", "))
+	}
+
+	return parser.NewTypedRegexParser(customParser.Name, customParser.Regex, customParser.Keep, customParser.Skip, customParser.Type, customParser.Link)
+}
+
+func supportedStrategies(file File) []string {
+	strategies := []string{"'squash'", "'merge'"}
+
+	for _, customParser := range file.Parser {
+		strategies = append(strategies, fmt.Sprintf("'%s'", customParser.Name))
+	}
+
+	return strategies
+}
+
+func getFilter(file File, repositoryName string) (filter.Filter, error) {
+	include, err := newFilterRules(file.General.Include)
+	if err != nil {
+		return filter.Filter{}, err
+	}
+
+	exclude, err := newFilterRules(file.General.Exclude)
+	if err != nil {
+		return filter.Filter{}, err
+	}
+
+	repository, ok := file.FindRepository(repositoryName)
+	if ok {
+		repositoryInclude, err := newFilterRules(repository.Include)
+		if err != nil {
+			return filter.Filter{}, err
+		}
+
+		repositoryExclude, err := newFilterRules(repository.Exclude)
+		if err != nil {
+			return filter.Filter{}, err
+		}
+
+		include = include.Merge(repositoryInclude)
+		exclude = exclude.Merge(repositoryExclude)
+	}
+
+	return filter.Filter{Include: include, Exclude: exclude}, nil
+}
+
+func newFilterRules(rules Filter) (filter.Rules, error) {
+	return filter.NewRules(rules.Labels, rules.Authors, rules.Titles)
+}
+
+func getFromReference(repository git.Git, command Command, toReference git.Reference) (git.Reference, error) {
+	if command.From != "" || !command.Unreleased {
+		return git.NewReference(command.From), nil
+	}
+
+	return repository.LatestTag(toReference)
+}
+
+func getVersionName(command Command) string {
+	if command.Unreleased {
+		return UnreleasedVersionName
+	}
+
+	return command.VersionName
 }
 
 func getToReference(file File, command Command, repositoryName string) git.Reference {
@@ -86,6 +491,15 @@ func getToReference(file File, command Command, repositoryName string) git.Refer
 	return git.NewReference("master")
 }
 
+func getRemote(repository git.Git, repositoryName string) *git.Remote {
+	remote, err := repository.FindRemote()
+	if err != nil {
+		return &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: repositoryName}
+	}
+
+	return &git.Remote{Type: remote.Type, Host: remote.Host, RepositoryName: repositoryName}
+}
+
 func getRepositoryName(repository git.Git, command Command) (string, error) {
 	repositoryName := command.RepositoryName
 	if repositoryName == "" {
@@ -1,15 +1,20 @@
 package configuration_test
 
 import (
+	"regexp"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/kdisneur/changelog/pkg/configuration"
+	"github.com/kdisneur/changelog/pkg/filter"
 	"github.com/kdisneur/changelog/pkg/formatter"
 	"github.com/kdisneur/changelog/pkg/git"
 	"github.com/kdisneur/changelog/pkg/git/system"
 	"github.com/kdisneur/changelog/pkg/github"
+	"github.com/kdisneur/changelog/pkg/parser"
+	"github.com/kdisneur/changelog/pkg/release"
+	"github.com/kdisneur/changelog/pkg/releasenote"
 	"github.com/kdisneur/changelog/pkg/testing/targz"
 )
 
@@ -27,6 +32,17 @@ func TestValidate(t *testing.T) {
 		CommandDate                time.Time
 		CommandRepositoryLocalPath string
 		CommandMergeStrategy       string
+		CommandContributors        bool
+		CommandReverts             string
+		CommandShippedIn           []string
+		CommandFormat              string
+		CommandToken               string
+		CommandAPIURL              string
+		CommandProfiles            []string
+		CommandDateSource          string
+		CommandTimezone            string
+		CommandUnreleased          bool
+		CommandOutput              string
 		Fixture                    string
 		IsValid                    bool
 		ErrorMessage               string
@@ -57,7 +73,9 @@ func TestValidate(t *testing.T) {
 					CommitParser: github.NewSquashParser(),
 					Formatter:    formatter.NewMarkdownFormatter(),
 					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
 					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
 				}
 			},
 		},
@@ -96,7 +114,9 @@ func TestValidate(t *testing.T) {
 					CommitParser: github.NewSquashParser(),
 					Formatter:    formatter.NewMarkdownFormatter(),
 					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
 					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
 				}
 			},
 		},
@@ -135,7 +155,9 @@ func TestValidate(t *testing.T) {
 					CommitParser: github.NewSquashParser(),
 					Formatter:    formatter.NewMarkdownFormatter(),
 					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
 					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
 				}
 			},
 		},
@@ -173,7 +195,9 @@ func TestValidate(t *testing.T) {
 					CommitParser: github.NewSquashParser(),
 					Formatter:    formatter.NewMarkdownFormatter(),
 					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
 					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
 				}
 			},
 		},
@@ -212,7 +236,9 @@ func TestValidate(t *testing.T) {
 					CommitParser: github.NewMergeParser(),
 					Formatter:    formatter.NewMarkdownFormatter(),
 					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
 					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
 				}
 			},
 		},
@@ -251,7 +277,9 @@ func TestValidate(t *testing.T) {
 					CommitParser: github.NewSquashParser(),
 					Formatter:    formatter.NewMarkdownFormatter(),
 					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
 					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
 				}
 			},
 		},
@@ -289,22 +317,62 @@ func TestValidate(t *testing.T) {
 					CommitParser: github.NewSquashParser(),
 					Formatter:    formatter.NewMarkdownFormatter(),
 					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
 					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
 				}
 			},
 		},
 		{
-			Name: "When configuration has no repository name but one remote is defined in Git",
+			Name: "When configuration enables contributors in the file",
 			File: configuration.File{
 				Github: configuration.GitHub{Token: ValidGitHubToken},
+				Contributors: configuration.Contributors{
+					Enabled: true,
+					Ignore:  []string{"dependabot[bot]"},
+				},
 			},
-			CommandRepositoryName: "",
+			CommandRepositoryName: ValidRepositoryName,
 			CommandFrom:           "v1.0.0",
 			CommandTo:             "master",
 			CommandVersionName:    "v1.0.1",
 			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
 			CommandMergeStrategy:  "squash",
-			Fixture:               "onegitremote",
+			Fixture:               "squash",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+
+				return &configuration.ValidatedConfig{
+					From:                git.Reference("v1.0.0"),
+					To:                  git.Reference("master"),
+					VersionName:         "v1.0.1",
+					Date:                time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+					CommitParser:        github.NewSquashParser(),
+					Formatter:           formatter.NewMarkdownFormatter(),
+					Repository:          repository,
+					Remote:              &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:          github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:             configuration.RevertsCancel,
+					Contributors:        true,
+					IgnoredContributors: []string{"dependabot[bot]"},
+				}
+			},
+		},
+		{
+			Name: "When configuration enables contributors in the command",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandMergeStrategy:  "squash",
+			CommandContributors:   true,
+			Fixture:               "squash",
 			IsValid:               true,
 			ErrorMessage:          "",
 			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
@@ -318,40 +386,924 @@ func TestValidate(t *testing.T) {
 					CommitParser: github.NewSquashParser(),
 					Formatter:    formatter.NewMarkdownFormatter(),
 					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
 					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
+					Contributors: true,
 				}
 			},
 		},
 		{
-			Name: "When configuration has no repository name but multiple remotes are defined in Git",
+			Name: "When configuration enables direct commits in the file",
 			File: configuration.File{
 				Github: configuration.GitHub{Token: ValidGitHubToken},
+				DirectCommits: configuration.DirectCommits{
+					Enabled: true,
+					Ignore:  []string{"^Bump version", "^Merge branch"},
+				},
 			},
-			CommandRepositoryName: "",
+			CommandRepositoryName: ValidRepositoryName,
 			CommandFrom:           "v1.0.0",
 			CommandTo:             "master",
 			CommandVersionName:    "v1.0.1",
 			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
 			CommandMergeStrategy:  "squash",
-			Fixture:               "multipledifferentremotes",
+			Fixture:               "squash",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+
+				return &configuration.ValidatedConfig{
+					From:                 git.Reference("v1.0.0"),
+					To:                   git.Reference("master"),
+					VersionName:          "v1.0.1",
+					Date:                 time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+					CommitParser:         github.NewSquashParser(),
+					Formatter:            formatter.NewMarkdownFormatter(),
+					Repository:           repository,
+					Remote:               &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:           github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:              configuration.RevertsCancel,
+					DirectCommits:        true,
+					IgnoredDirectCommits: []*regexp.Regexp{regexp.MustCompile("^Bump version"), regexp.MustCompile("^Merge branch")},
+				}
+			},
+		},
+		{
+			Name: "When configuration has an invalid direct commit ignore pattern",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+				DirectCommits: configuration.DirectCommits{
+					Ignore: []string{"^Bump ("},
+				},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandMergeStrategy:  "squash",
+			Fixture:               "squash",
 			IsValid:               false,
-			ErrorMessage:          "found multiple remotes",
+			ErrorMessage:          "can't compile direct commit ignore pattern '^Bump ('",
 			ExpectedBuilder:       func(path string) *configuration.ValidatedConfig { return nil },
 		},
 		{
-			Name: "When configuration contains an unsupported merging strategy",
+			Name: "When configuration lists reverted changes in the file",
+			File: configuration.File{
+				General: configuration.General{Reverts: "list"},
+				Github:  configuration.GitHub{Token: ValidGitHubToken},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandMergeStrategy:  "squash",
+			Fixture:               "squash",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+
+				return &configuration.ValidatedConfig{
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("master"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsList,
+				}
+			},
+		},
+		{
+			Name: "When configuration asks for an unknown reverts behaviour",
+			File: configuration.File{
+				General: configuration.General{Reverts: "list"},
+				Github:  configuration.GitHub{Token: ValidGitHubToken},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandMergeStrategy:  "squash",
+			CommandReverts:        "drop",
+			Fixture:               "squash",
+			IsValid:               false,
+			ErrorMessage:          "Asked for 'drop' reverts but support only 'cancel', 'list' or 'keep'",
+			ExpectedBuilder:       func(path string) *configuration.ValidatedConfig { return nil },
+		},
+		{
+			Name: "When configuration excludes the changes shipped in a maintenance branch",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.1.0",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandMergeStrategy:  "squash",
+			CommandShippedIn:      []string{"abranch"},
+			Fixture:               "squash",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+
+				return &configuration.ValidatedConfig{
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("master"),
+					VersionName:  "v1.1.0",
+					Date:         time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
+					ShippedIn:    []git.Reference{"abranch"},
+				}
+			},
+		},
+		{
+			Name: "When configuration has general and repository filters",
 			File: configuration.File{
+				General: configuration.General{
+					Exclude: configuration.Filter{Labels: []string{"skip-changelog"}, Authors: []string{"dependabot[bot]"}},
+				},
 				Github: configuration.GitHub{Token: ValidGitHubToken},
+				Repository: []configuration.GitRepository{
+					{
+						Name:    ValidRepositoryName,
+						Include: configuration.Filter{Labels: []string{"user-facing"}},
+						Exclude: configuration.Filter{Labels: []string{"internal"}, Titles: []string{"^chore"}},
+					},
+				},
 			},
 			CommandRepositoryName: ValidRepositoryName,
 			CommandFrom:           "v1.0.0",
 			CommandTo:             "master",
 			CommandVersionName:    "v1.0.1",
 			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
-			CommandMergeStrategy:  "wrong-strategy",
+			CommandMergeStrategy:  "squash",
+			Fixture:               "squash",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+				include, _ := filter.NewRules([]string{"user-facing"}, nil, nil)
+				exclude, _ := filter.NewRules([]string{"skip-changelog", "internal"}, []string{"dependabot[bot]"}, []string{"^chore"})
+
+				return &configuration.ValidatedConfig{
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("master"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
+					Filter:       filter.Filter{Include: include, Exclude: exclude},
+				}
+			},
+		},
+		{
+			Name: "When configuration contains an invalid title filter",
+			File: configuration.File{
+				General: configuration.General{
+					Exclude: configuration.Filter{Titles: []string{"(chore"}},
+				},
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandMergeStrategy:  "squash",
 			Fixture:               "squash",
 			IsValid:               false,
-			ErrorMessage:          "Asked for 'wrong-strategy' strategy but support only",
+			ErrorMessage:          "can't compile title pattern '(chore'",
+			ExpectedBuilder:       func(path string) *configuration.ValidatedConfig { return nil },
+		},
+		{
+			Name: "When configuration enables release notes in the file",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+				ReleaseNotes: configuration.ReleaseNotes{
+					Enabled: true,
+					Heading: "User facing change",
+				},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandMergeStrategy:  "squash",
+			Fixture:               "squash",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+
+				return &configuration.ValidatedConfig{
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("master"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
+					ReleaseNotes: releasenote.NewExtractor("User facing change", "release-note"),
+				}
+			},
+		},
+		{
+			Name: "When configuration enables linked issues in the file",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken, LinkedIssues: true},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandMergeStrategy:  "squash",
+			Fixture:               "squash",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+
+				return &configuration.ValidatedConfig{
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("master"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:   github.NewBugTrackerWithOptions(ValidGitHubToken, "https://api.github.com", ValidRepositoryName, github.Options{LinkedIssues: true}),
+					Reverts:      configuration.RevertsCancel,
+				}
+			},
+		},
+		{
+			Name: "When configuration enables compare links in the file",
+			File: configuration.File{
+				General: configuration.General{CompareLinks: true},
+				Github:  configuration.GitHub{Token: ValidGitHubToken},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandMergeStrategy:  "squash",
+			Fixture:               "squash",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+
+				return &configuration.ValidatedConfig{
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("master"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
+					CompareLinks: true,
+				}
+			},
+		},
+		{
+			Name: "When configuration defines the packaging",
+			File: configuration.File{
+				Github:    configuration.GitHub{Token: ValidGitHubToken},
+				Packaging: configuration.Packaging{Name: "changelog-cli", Distribution: "stable", Urgency: "low", Maintainer: "Jane Doe <jane@example.com>"},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandMergeStrategy:  "squash",
+			Fixture:               "squash",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+
+				return &configuration.ValidatedConfig{
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("master"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
+					Package: release.Package{
+						Name:         "changelog-cli",
+						Distribution: "stable",
+						Urgency:      "low",
+						Maintainer:   git.Person{Fullname: "Jane Doe", Email: "jane@example.com"},
+					},
+				}
+			},
+		},
+		{
+			Name: "When configuration has an unknown packaging urgency",
+			File: configuration.File{
+				Github:    configuration.GitHub{Token: ValidGitHubToken},
+				Packaging: configuration.Packaging{Urgency: "urgent"},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			Fixture:               "squash",
+			IsValid:               false,
+			ErrorMessage:          "Asked for 'urgent' urgency but support only 'low', 'medium', 'high', 'emergency' or 'critical'",
+			ExpectedBuilder:       func(path string) *configuration.ValidatedConfig { return nil },
+		},
+		{
+			Name: "When configuration has an invalid packaging maintainer",
+			File: configuration.File{
+				Github:    configuration.GitHub{Token: ValidGitHubToken},
+				Packaging: configuration.Packaging{Maintainer: "jane@example.com"},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			Fixture:               "squash",
+			IsValid:               false,
+			ErrorMessage:          "can't parse maintainer 'jane@example.com', expected 'Full Name <email>'",
+			ExpectedBuilder:       func(path string) *configuration.ValidatedConfig { return nil },
+		},
+		{
+			Name: "When configuration has no repository name but one remote is defined in Git",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+			},
+			CommandRepositoryName: "",
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandMergeStrategy:  "squash",
+			Fixture:               "onegitremote",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+
+				return &configuration.ValidatedConfig{
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("master"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Repository:   repository,
+					Remote:       &git.Remote{Type: git.GIT, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
+				}
+			},
+		},
+		{
+			Name: "When configuration has no repository name but multiple remotes are defined in Git",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+			},
+			CommandRepositoryName: "",
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandMergeStrategy:  "squash",
+			Fixture:               "multipledifferentremotes",
+			IsValid:               false,
+			ErrorMessage:          "found multiple remotes",
+			ExpectedBuilder:       func(path string) *configuration.ValidatedConfig { return nil },
+		},
+		{
+			Name: "When configuration asks for a custom parser",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+				Parser: []configuration.CommitParser{
+					{
+						Name:  "bracket",
+						Regex: "\\[#(?P<id>[0-9]+)\\]",
+						Skip:  "^Bump ",
+					},
+				},
+				Repository: []configuration.GitRepository{
+					{
+						Name:          ValidRepositoryName,
+						MergeStrategy: "bracket",
+					},
+				},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandMergeStrategy:  "",
+			Fixture:               "squash",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+				bracketParser, _ := parser.NewRegexParser("bracket", "\\[#(?P<id>[0-9]+)\\]", "", "^Bump ")
+
+				return &configuration.ValidatedConfig{
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("master"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+					CommitParser: bracketParser,
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
+				}
+			},
+		},
+		{
+			Name: "When configuration contains an invalid custom parser",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+				Parser: []configuration.CommitParser{
+					{
+						Name:  "bracket",
+						Regex: "\\[#([0-9]+)\\]",
+					},
+				},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandMergeStrategy:  "bracket",
+			Fixture:               "squash",
+			IsValid:               false,
+			ErrorMessage:          "regex of parser 'bracket' must contain a named group",
+			ExpectedBuilder:       func(path string) *configuration.ValidatedConfig { return nil },
+		},
+		{
+			Name: "When configuration asks for several strategies",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+				Parser: []configuration.CommitParser{
+					{
+						Name:  "jira",
+						Regex: "(?P<id>[A-Z]+-[0-9]+)",
+						Type:  "jira",
+						Link:  "https://jira.example.com/browse/{id}",
+					},
+				},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandMergeStrategy:  "squash, merge, jira",
+			Fixture:               "squash",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+				jiraParser, _ := parser.NewTypedRegexParser("jira", "(?P<id>[A-Z]+-[0-9]+)", "", "", "jira", "https://jira.example.com/browse/{id}")
+
+				return &configuration.ValidatedConfig{
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("master"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+					CommitParser: parser.NewChainParser(github.NewSquashParser(), github.NewMergeParser(), jiraParser),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
+				}
+			},
+		},
+		{
+			Name: "When configuration contains an unsupported merging strategy",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandMergeStrategy:  "wrong-strategy",
+			Fixture:               "squash",
+			IsValid:               false,
+			ErrorMessage:          "Asked for 'wrong-strategy' strategy but support only",
+			ExpectedBuilder:       func(path string) *configuration.ValidatedConfig { return nil },
+		},
+		{
+			Name: "When configuration defines the format, token and API URL in the file",
+			File: configuration.File{
+				General: configuration.General{Format: "json"},
+				Github:  configuration.GitHub{Token: ValidGitHubToken, APIURL: "https://github.example.com/api/v3"},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			Fixture:               "squash",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+
+				return &configuration.ValidatedConfig{
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("master"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewJSONFormatter(),
+					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:   github.NewBugTrackerWithAPI(ValidGitHubToken, "https://github.example.com/api/v3", ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
+				}
+			},
+		},
+		{
+			Name: "When configuration has command format, token and API URL overriding the file ones",
+			File: configuration.File{
+				General: configuration.General{Format: "json"},
+				Github:  configuration.GitHub{Token: ValidGitHubToken, APIURL: "https://github.example.com/api/v3"},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandFormat:         "markdown",
+			CommandToken:          "eeee-ffff",
+			CommandAPIURL:         "http://localhost:8080",
+			Fixture:               "squash",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+
+				return &configuration.ValidatedConfig{
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("master"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:   github.NewBugTrackerWithAPI("eeee-ffff", "http://localhost:8080", ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
+				}
+			},
+		},
+		{
+			Name: "When configuration contains an unknown format",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandFormat:         "yaml",
+			Fixture:               "squash",
+			IsValid:               false,
+			ErrorMessage:          "unknown format 'yaml'",
+			ExpectedBuilder:       func(path string) *configuration.ValidatedConfig { return nil },
+		},
+		{
+			Name: "When configuration asks for profiles",
+			File: configuration.File{
+				General:      configuration.General{Exclude: configuration.Filter{Labels: []string{"dependencies"}}},
+				Github:       configuration.GitHub{Token: ValidGitHubToken},
+				Contributors: configuration.Contributors{Enabled: true},
+				Profile: map[string]configuration.Profile{
+					"internal": {},
+					"public": {
+						Format:    "json",
+						Sections:  []string{"changes"},
+						HideLinks: true,
+						Output:    "CHANGELOG.public.json",
+						Include:   configuration.Filter{Labels: []string{"user-facing"}},
+					},
+				},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandProfiles:       []string{"internal", "public"},
+			Fixture:               "squash",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+				exclude, _ := filter.NewRules([]string{"dependencies"}, nil, nil)
+				include, _ := filter.NewRules([]string{"user-facing"}, nil, nil)
+
+				return &configuration.ValidatedConfig{
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("master"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Filter:       filter.Filter{Exclude: exclude},
+					Contributors: true,
+					Reverts:      configuration.RevertsCancel,
+					Profiles: []*configuration.ValidatedProfile{
+						{
+							Name:      "internal",
+							Formatter: formatter.NewMarkdownFormatter(),
+							Filter:    filter.Filter{Exclude: exclude},
+							Sections:  []string{"changes", "contributors"},
+						},
+						{
+							Name:      "public",
+							Formatter: formatter.NewJSONFormatter(),
+							Filter:    filter.Filter{Include: include, Exclude: exclude},
+							Sections:  []string{"changes"},
+							HideLinks: true,
+							Output:    "CHANGELOG.public.json",
+						},
+					},
+				}
+			},
+		},
+		{
+			Name: "When configuration asks for an unknown profile",
+			File: configuration.File{
+				Github:  configuration.GitHub{Token: ValidGitHubToken},
+				Profile: map[string]configuration.Profile{"public": {}},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandProfiles:       []string{"internal"},
+			Fixture:               "squash",
+			IsValid:               false,
+			ErrorMessage:          "Asked for 'internal' profile but support only 'public'",
+			ExpectedBuilder:       func(path string) *configuration.ValidatedConfig { return nil },
+		},
+		{
+			Name: "When configuration contains a profile with an unknown section",
+			File: configuration.File{
+				Github:  configuration.GitHub{Token: ValidGitHubToken},
+				Profile: map[string]configuration.Profile{"public": {Sections: []string{"changes", "security"}}},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandProfiles:       []string{"public"},
+			Fixture:               "squash",
+			IsValid:               false,
+			ErrorMessage:          "profile 'public': unknown section 'security'",
+			ExpectedBuilder:       func(path string) *configuration.ValidatedConfig { return nil },
+		},
+		{
+			Name: "When configuration has a command date in a timezone",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandDateSource:     "2018-11-21",
+			CommandTimezone:       "Europe/Paris",
+			Fixture:               "squash",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+
+				return &configuration.ValidatedConfig{
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("master"),
+					VersionName:  "v1.0.1",
+					Date:         time.Date(2018, time.November, 20, 23, 0, 0, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
+				}
+			},
+		},
+		{
+			Name: "When configuration takes the date from the tag",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "v1.0.0",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandDateSource:     "tag",
+			CommandTimezone:       "UTC",
+			Fixture:               "squash",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+
+				return &configuration.ValidatedConfig{
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("v1.0.0"),
+					VersionName:  "v1.0.1",
+					Date:         time.Unix(1542432638, 0),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
+				}
+			},
+		},
+		{
+			Name: "When configuration takes the date from the commit",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandDateSource:     "commit",
+			CommandTimezone:       "UTC",
+			Fixture:               "squash",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+
+				return &configuration.ValidatedConfig{
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("master"),
+					VersionName:  "v1.0.1",
+					Date:         time.Unix(1542483321, 0),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
+				}
+			},
+		},
+		{
+			Name: "When configuration takes the date from a reference which isn't a tag",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandDateSource:     "tag",
+			CommandTimezone:       "",
+			Fixture:               "squash",
+			IsValid:               false,
+			ErrorMessage:          "Can't find git tag 'master'",
+			ExpectedBuilder:       func(path string) *configuration.ValidatedConfig { return nil },
+		},
+		{
+			Name: "When configuration has an invalid command date",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandDateSource:     "21/11/2018",
+			CommandTimezone:       "",
+			Fixture:               "squash",
+			IsValid:               false,
+			ErrorMessage:          "can't parse date '21/11/2018', expected YYYY-MM-DD, 'tag' or 'commit'",
+			ExpectedBuilder:       func(path string) *configuration.ValidatedConfig { return nil },
+		},
+		{
+			Name: "When configuration has an unknown timezone",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandFrom:           "v1.0.0",
+			CommandTo:             "master",
+			CommandVersionName:    "v1.0.1",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandDateSource:     "",
+			CommandTimezone:       "Mars/Olympus_Mons",
+			Fixture:               "squash",
+			IsValid:               false,
+			ErrorMessage:          "unknown timezone 'Mars/Olympus_Mons'",
+			ExpectedBuilder:       func(path string) *configuration.ValidatedConfig { return nil },
+		},
+		{
+			Name: "When configuration generates the unreleased section",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandTo:             "master",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandUnreleased:     true,
+			CommandOutput:         "CHANGELOG.md",
+			Fixture:               "squash",
+			IsValid:               true,
+			ErrorMessage:          "",
+			ExpectedBuilder: func(path string) *configuration.ValidatedConfig {
+				repository, _ := system.NewRepository(path)
+
+				return &configuration.ValidatedConfig{
+					From:         git.Reference("v1.0.0"),
+					To:           git.Reference("master"),
+					VersionName:  "Unreleased",
+					Date:         time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+					CommitParser: github.NewSquashParser(),
+					Formatter:    formatter.NewMarkdownFormatter(),
+					Repository:   repository,
+					Remote:       &git.Remote{Type: git.HTTPS, Host: "github.com", RepositoryName: ValidRepositoryName},
+					BugTracker:   github.NewBugTracker(ValidGitHubToken, ValidRepositoryName),
+					Reverts:      configuration.RevertsCancel,
+					Unreleased:   true,
+					Output:       "CHANGELOG.md",
+				}
+			},
+		},
+		{
+			Name: "When configuration generates the unreleased section without any tag",
+			File: configuration.File{
+				Github: configuration.GitHub{Token: ValidGitHubToken},
+			},
+			CommandRepositoryName: ValidRepositoryName,
+			CommandTo:             "6398b4e",
+			CommandDate:           time.Date(2018, time.November, 21, 5, 45, 12, 0, time.UTC),
+			CommandUnreleased:     true,
+			Fixture:               "squash",
+			IsValid:               false,
+			ErrorMessage:          "Can't find a git tag reachable from '6398b4e'",
 			ExpectedBuilder:       func(path string) *configuration.ValidatedConfig { return nil },
 		},
 		{
@@ -378,7 +1330,7 @@ func TestValidate(t *testing.T) {
 			if testCase.CommandRepositoryLocalPath == "" && testCase.Fixture != "" {
 				repositoryPath, cleanup, err := targz.Untar(testCase.Fixture)
 				if err != nil {
-					t.Fatalf(err.Error())
+					t.Fatal(err)
 				}
 
 				defer cleanup()
@@ -394,6 +1346,17 @@ func TestValidate(t *testing.T) {
 				Date:                testCase.CommandDate,
 				RepositoryLocalPath: testCase.CommandRepositoryLocalPath,
 				MergeStrategy:       testCase.CommandMergeStrategy,
+				Contributors:        testCase.CommandContributors,
+				Reverts:             testCase.CommandReverts,
+				ShippedIn:           testCase.CommandShippedIn,
+				Format:              testCase.CommandFormat,
+				Token:               testCase.CommandToken,
+				APIURL:              testCase.CommandAPIURL,
+				Profiles:            testCase.CommandProfiles,
+				DateSource:          testCase.CommandDateSource,
+				Timezone:            testCase.CommandTimezone,
+				Unreleased:          testCase.CommandUnreleased,
+				Output:              testCase.CommandOutput,
 			}
 
 			config, err := configuration.Validate(testCase.File, command)
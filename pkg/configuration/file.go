@@ -1,5 +1,10 @@
 package configuration
 
+import (
+	"sort"
+	"strings"
+)
+
 func (f File) FindRepository(name string) (*GitRepository, bool) {
 	for _, repository := range f.Repository {
 		if repository.Name == name {
@@ -9,3 +14,34 @@ func (f File) FindRepository(name string) (*GitRepository, bool) {
 
 	return nil, false
 }
+
+func (f File) FindParser(name string) (*CommitParser, bool) {
+	for _, parser := range f.Parser {
+		if parser.Name == name {
+			return &parser, true
+		}
+	}
+
+	return nil, false
+}
+
+func (f File) FindProfile(name string) (*Profile, bool) {
+	for profileName, profile := range f.Profile {
+		if strings.EqualFold(profileName, name) {
+			return &profile, true
+		}
+	}
+
+	return nil, false
+}
+
+func (f File) ProfileNames() []string {
+	var names []string
+
+	for name := range f.Profile {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
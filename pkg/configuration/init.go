@@ -0,0 +1,108 @@
+package configuration
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/kdisneur/changelog/pkg/git"
+	"github.com/kdisneur/changelog/pkg/github"
+)
+
+const sampledCommitsCount = 100
+
+type Detection struct {
+	RepositoryName string
+	Host           string
+	BaseBranch     string
+	MergeStrategy  string
+	SampledCommits int
+	SquashCommits  int
+	MergeCommits   int
+}
+
+func Detect(repository git.Git) *Detection {
+	detection := &Detection{MergeStrategy: "squash"}
+
+	remote, err := repository.FindRemote()
+	if err == nil {
+		detection.RepositoryName = remote.RepositoryName
+		detection.Host = remote.Host
+	}
+
+	baseBranch, err := repository.DefaultBranch()
+	if err == nil {
+		detection.BaseBranch = baseBranch
+	}
+
+	commits, err := repository.RecentCommits(sampledCommitsCount)
+	if err != nil {
+		return detection
+	}
+
+	squashParser := github.NewSquashParser()
+	mergeParser := github.NewMergeParser()
+	for _, commit := range commits {
+		if commit.IsMerge && mergeParser.KeepCommit(commit.Message) {
+			detection.MergeCommits++
+		} else if squashParser.KeepCommit(commit.Message) {
+			detection.SquashCommits++
+		}
+	}
+
+	detection.SampledCommits = len(commits)
+	if detection.MergeCommits > detection.SquashCommits {
+		detection.MergeStrategy = "merge"
+	}
+
+	return detection
+}
+
+func Render(detection *Detection, token string, local bool) string {
+	var content bytes.Buffer
+
+	content.WriteString("# Generated by `changelog init`. Every option is documented in\n")
+	content.WriteString("# https://github.com/kdisneur/changelog#configuration-option\n")
+	if detection.Host != "" {
+		content.WriteString(fmt.Sprintf("#\n# Detected remote host: %s\n", detection.Host))
+	}
+
+	content.WriteString("\n[general]\n")
+	if local || detection.RepositoryName == "" {
+		writeDetectedSettings(&content, detection)
+	} else {
+		content.WriteString("# settings shared by every repository, overridden by the [[repository]] sections\n")
+	}
+
+	content.WriteString("\n[github]\n")
+	if local {
+		content.WriteString("# token = \"\" # keep the token out of the repository, in ~/.config/changelog.toml\n")
+	} else {
+		content.WriteString(fmt.Sprintf("token = %q # a GitHub token allowed to read the pull-requests of the repositories\n", token))
+	}
+
+	if !local && detection.RepositoryName != "" {
+		content.WriteString(fmt.Sprintf("\n[[repository]]\nname = %q\n", detection.RepositoryName))
+		writeDetectedSettings(&content, detection)
+	}
+
+	return content.String()
+}
+
+func writeDetectedSettings(content *bytes.Buffer, detection *Detection) {
+	strategyComment := "either squash or merge"
+	if detection.SampledCommits > 0 {
+		strategyComment = fmt.Sprintf(
+			"either squash or merge. The last %d commits contain %d squashed and %d merged pull-requests",
+			detection.SampledCommits,
+			detection.SquashCommits,
+			detection.MergeCommits,
+		)
+	}
+	content.WriteString(fmt.Sprintf("mergeStrategy = %q # %s\n", detection.MergeStrategy, strategyComment))
+
+	if detection.BaseBranch != "" {
+		content.WriteString(fmt.Sprintf("baseBranch = %q # the main git branch you merge to\n", detection.BaseBranch))
+	} else {
+		content.WriteString("# baseBranch = \"master\" # the main git branch you merge to\n")
+	}
+}
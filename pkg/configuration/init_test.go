@@ -0,0 +1,165 @@
+package configuration_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kdisneur/changelog/pkg/configuration"
+	"github.com/kdisneur/changelog/pkg/git"
+	"github.com/kdisneur/changelog/pkg/testing/repository"
+)
+
+func TestDetect(t *testing.T) {
+	author := git.Person{Fullname: "John Doe", Email: "john@example.com"}
+	at := time.Date(2019, time.January, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name              string
+		RemoteURL         string
+		Setup             func(repository *repository.Repository)
+		ExpectedDetection *configuration.Detection
+	}{
+		{
+			"When the repository is squashing pull-requests",
+			"git@github.com:kdisneur/changelog.git",
+			func(repository *repository.Repository) {
+				repository.AddCommit("aaaaaaa", author, at, "Adding feature 1 (#1)")
+				repository.AddCommit("bbbbbbb", author, at, "Fix typo")
+				repository.AddCommit("ccccccc", author, at, "Adding feature 2 (#2)")
+			},
+			&configuration.Detection{
+				RepositoryName: "kdisneur/changelog",
+				Host:           "github.com",
+				BaseBranch:     "master",
+				MergeStrategy:  "squash",
+				SampledCommits: 3,
+				SquashCommits:  2,
+				MergeCommits:   0,
+			},
+		},
+		{
+			"When the repository is merging pull-requests",
+			"https://github.com/kdisneur/changelog",
+			func(repository *repository.Repository) {
+				repository.SetDefaultBranch("main")
+				repository.AddCommit("aaaaaaa", author, at, "Adding feature 1")
+				repository.AddMergeCommit("bbbbbbb", author, at, "Merge pull request #1 from kdisneur/feature-1")
+				repository.AddCommit("ccccccc", author, at, "Adding feature 2")
+				repository.AddMergeCommit("ddddddd", author, at, "Merge pull request #2 from kdisneur/feature-2")
+			},
+			&configuration.Detection{
+				RepositoryName: "kdisneur/changelog",
+				Host:           "github.com",
+				BaseBranch:     "main",
+				MergeStrategy:  "merge",
+				SampledCommits: 4,
+				SquashCommits:  0,
+				MergeCommits:   2,
+			},
+		},
+		{
+			"When the repository has no commits",
+			"git@github.com:kdisneur/changelog.git",
+			func(repository *repository.Repository) {},
+			&configuration.Detection{
+				RepositoryName: "kdisneur/changelog",
+				Host:           "github.com",
+				BaseBranch:     "master",
+				MergeStrategy:  "squash",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			fakeRepository := repository.New(testCase.RemoteURL)
+			testCase.Setup(fakeRepository)
+
+			detection := configuration.Detect(fakeRepository)
+
+			if *detection != *testCase.ExpectedDetection {
+				t.Errorf("Wrong detection.\nExpected: %+v\nReceived: %+v", testCase.ExpectedDetection, detection)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	detection := &configuration.Detection{
+		RepositoryName: "kdisneur/changelog",
+		Host:           "github.com",
+		BaseBranch:     "main",
+		MergeStrategy:  "merge",
+		SampledCommits: 4,
+		SquashCommits:  0,
+		MergeCommits:   2,
+	}
+
+	testCases := []struct {
+		Name             string
+		Detection        *configuration.Detection
+		Token            string
+		Local            bool
+		ExpectedLines    []string
+		NotExpectedLines []string
+	}{
+		{
+			"When rendering a global file",
+			detection,
+			"aaaa-bbbb",
+			false,
+			[]string{
+				`token = "aaaa-bbbb"`,
+				"[[repository]]",
+				`name = "kdisneur/changelog"`,
+				`mergeStrategy = "merge" # either squash or merge. The last 4 commits contain 0 squashed and 2 merged pull-requests`,
+				`baseBranch = "main"`,
+			},
+			nil,
+		},
+		{
+			"When rendering a local file",
+			detection,
+			"aaaa-bbbb",
+			true,
+			[]string{
+				"[general]",
+				`mergeStrategy = "merge"`,
+				`baseBranch = "main"`,
+				`# token = ""`,
+			},
+			[]string{"aaaa-bbbb", "[[repository]]"},
+		},
+		{
+			"When nothing was detected",
+			&configuration.Detection{MergeStrategy: "squash"},
+			"",
+			false,
+			[]string{
+				`mergeStrategy = "squash" # either squash or merge`,
+				`# baseBranch = "master"`,
+				`token = ""`,
+			},
+			[]string{"[[repository]]", "Detected remote host"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			content := configuration.Render(testCase.Detection, testCase.Token, testCase.Local)
+
+			for _, line := range testCase.ExpectedLines {
+				if !strings.Contains(content, line) {
+					t.Errorf("Expected content to contain '%s'.\nReceived: %s", line, content)
+				}
+			}
+
+			for _, line := range testCase.NotExpectedLines {
+				if strings.Contains(content, line) {
+					t.Errorf("Expected content not to contain '%s'.\nReceived: %s", line, content)
+				}
+			}
+		})
+	}
+}
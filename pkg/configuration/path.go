@@ -8,6 +8,8 @@ import (
 )
 
 const DEFAULT_NAME = "changelog"
+const DEFAULT_EXTENSION = "toml"
+const LOCAL_NAME = ".changelog.toml"
 
 func DefaultFolderPath() (string, error) {
 	folder, err := homedir.Expand("~/.config")
@@ -30,3 +32,7 @@ func DefaultFilePath() (string, error) {
 
 	return path.Join(folder, DefaultFileName()), nil
 }
+
+func LocalFilePath(repositoryPath string) string {
+	return path.Join(repositoryPath, LOCAL_NAME)
+}
@@ -1,33 +1,121 @@
 package configuration
 
 import (
+	"regexp"
 	"time"
 
 	"github.com/kdisneur/changelog/pkg/bugtracker"
+	"github.com/kdisneur/changelog/pkg/filter"
 	"github.com/kdisneur/changelog/pkg/formatter"
 	"github.com/kdisneur/changelog/pkg/git"
 	"github.com/kdisneur/changelog/pkg/parser"
+	"github.com/kdisneur/changelog/pkg/release"
+	"github.com/kdisneur/changelog/pkg/releasenote"
+)
+
+const (
+	RevertsCancel = "cancel"
+	RevertsList   = "list"
+	RevertsKeep   = "keep"
+)
+
+const (
+	DateFromTag    = "tag"
+	DateFromCommit = "commit"
+)
+
+const UnreleasedVersionName = "Unreleased"
+
+const (
+	SectionChanges      = "changes"
+	SectionOtherChanges = "other changes"
+	SectionReverted     = "reverted"
+	SectionContributors = "contributors"
 )
 
 type File struct {
-	General    General
-	Github     GitHub
-	Repository []GitRepository
+	General       General
+	Github        GitHub
+	Contributors  Contributors
+	ReleaseNotes  ReleaseNotes
+	DirectCommits DirectCommits
+	Parser        []CommitParser
+	Repository    []GitRepository
+	Profile       map[string]Profile
+	Packaging     Packaging
 }
 
 type General struct {
 	MergeStrategy string
 	BaseBranch    string
+	Tolerant      bool
+	Reverts       string
+	Format        string
+	Timezone      string
+	CompareLinks  bool
+	Include       Filter
+	Exclude       Filter
+}
+
+type Filter struct {
+	Labels  []string
+	Authors []string
+	Titles  []string
 }
 
 type GitHub struct {
-	Token string
+	Token        string
+	APIURL       string
+	LinkedIssues bool
+}
+
+type Contributors struct {
+	Enabled bool
+	Ignore  []string
+}
+
+type ReleaseNotes struct {
+	Enabled bool
+	Heading string
+	Marker  string
+}
+
+type Packaging struct {
+	Name         string
+	Distribution string
+	Urgency      string
+	Maintainer   string
+}
+
+type DirectCommits struct {
+	Enabled bool
+	Ignore  []string
+}
+
+type CommitParser struct {
+	Name  string
+	Regex string
+	Keep  string
+	Skip  string
+	Type  string
+	Link  string
 }
 
 type GitRepository struct {
 	Name          string
 	BaseBranch    string
 	MergeStrategy string
+	Include       Filter
+	Exclude       Filter
+}
+
+type Profile struct {
+	Format    string
+	Sections  []string
+	HideLinks bool
+	Output    string
+	Include   Filter
+	Exclude   Filter
 }
 
 type Command struct {
@@ -36,19 +124,59 @@ type Command struct {
 	To                  string
 	VersionName         string
 	Date                time.Time
+	DateSource          string
+	Timezone            string
 	RepositoryLocalPath string
 	MergeStrategy       string
+	Contributors        bool
+	ReleaseNotes        bool
+	LinkedIssues        bool
+	Tolerant            bool
+	DirectCommits       bool
+	Reverts             string
+	ShippedIn           []string
+	Format              string
+	Token               string
+	APIURL              string
+	Profiles            []string
+	Unreleased          bool
+	Output              string
+	CompareLinks        bool
 }
 
 type ValidatedConfig struct {
-	From         git.Reference
-	To           git.Reference
-	VersionName  string
-	Date         time.Time
-	CommitParser parser.Parser
-	Formatter    formatter.Formatter
-	Repository   git.Git
-	BugTracker   bugtracker.BugTracker
+	From                 git.Reference
+	To                   git.Reference
+	VersionName          string
+	Date                 time.Time
+	CommitParser         parser.Parser
+	Formatter            formatter.Formatter
+	Repository           git.Git
+	Remote               *git.Remote
+	BugTracker           bugtracker.BugTracker
+	Filter               filter.Filter
+	Contributors         bool
+	IgnoredContributors  []string
+	ReleaseNotes         *releasenote.Extractor
+	Tolerant             bool
+	DirectCommits        bool
+	IgnoredDirectCommits []*regexp.Regexp
+	Reverts              string
+	ShippedIn            []git.Reference
+	Profiles             []*ValidatedProfile
+	Unreleased           bool
+	Output               string
+	CompareLinks         bool
+	Package              release.Package
+}
+
+type ValidatedProfile struct {
+	Name      string
+	Formatter formatter.Formatter
+	Filter    filter.Filter
+	Sections  []string
+	HideLinks bool
+	Output    string
 }
 
 func (c *ValidatedConfig) Equal(other *ValidatedConfig) bool {
@@ -59,5 +187,95 @@ func (c *ValidatedConfig) Equal(other *ValidatedConfig) bool {
 		c.CommitParser.Equal(other.CommitParser) &&
 		c.Formatter.Equal(other.Formatter) &&
 		c.Repository.Equal(other.Repository) &&
-		c.BugTracker.Equal(other.BugTracker)
+		c.Remote.Equal(other.Remote) &&
+		c.BugTracker.Equal(other.BugTracker) &&
+		c.Filter.Equal(other.Filter) &&
+		c.Contributors == other.Contributors &&
+		equalStrings(c.IgnoredContributors, other.IgnoredContributors) &&
+		c.ReleaseNotes.Equal(other.ReleaseNotes) &&
+		c.Tolerant == other.Tolerant &&
+		c.DirectCommits == other.DirectCommits &&
+		equalPatterns(c.IgnoredDirectCommits, other.IgnoredDirectCommits) &&
+		c.Reverts == other.Reverts &&
+		equalReferences(c.ShippedIn, other.ShippedIn) &&
+		equalProfiles(c.Profiles, other.Profiles) &&
+		c.Unreleased == other.Unreleased &&
+		c.Output == other.Output &&
+		c.CompareLinks == other.CompareLinks &&
+		c.Package == other.Package
+}
+
+func (p *ValidatedProfile) Equal(other *ValidatedProfile) bool {
+	return p.Name == other.Name &&
+		p.Formatter.Equal(other.Formatter) &&
+		p.Filter.Equal(other.Filter) &&
+		equalStrings(p.Sections, other.Sections) &&
+		p.HideLinks == other.HideLinks &&
+		p.Output == other.Output
+}
+
+func (p *ValidatedProfile) HasSection(section string) bool {
+	for _, existing := range p.Sections {
+		if existing == section {
+			return true
+		}
+	}
+
+	return false
+}
+
+func equalProfiles(profiles []*ValidatedProfile, others []*ValidatedProfile) bool {
+	if len(profiles) != len(others) {
+		return false
+	}
+
+	for index, profile := range profiles {
+		if !profile.Equal(others[index]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalStrings(values []string, others []string) bool {
+	if len(values) != len(others) {
+		return false
+	}
+
+	for index, value := range values {
+		if value != others[index] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalReferences(references []git.Reference, others []git.Reference) bool {
+	if len(references) != len(others) {
+		return false
+	}
+
+	for index, reference := range references {
+		if reference != others[index] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalPatterns(patterns []*regexp.Regexp, others []*regexp.Regexp) bool {
+	if len(patterns) != len(others) {
+		return false
+	}
+
+	for index, pattern := range patterns {
+		if pattern.String() != others[index].String() {
+			return false
+		}
+	}
+
+	return true
 }
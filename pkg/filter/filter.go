@@ -0,0 +1,135 @@
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kdisneur/changelog/pkg/bugtracker"
+)
+
+type Rules struct {
+	Labels  []string
+	Authors []string
+	Titles  []*regexp.Regexp
+}
+
+type Filter struct {
+	Include Rules
+	Exclude Rules
+}
+
+type Exclusion struct {
+	Issue  *bugtracker.Issue
+	Reason string
+}
+
+func NewRules(labels []string, authors []string, titles []string) (Rules, error) {
+	var patterns []*regexp.Regexp
+
+	for _, title := range titles {
+		pattern, err := regexp.Compile(title)
+		if err != nil {
+			return Rules{}, fmt.Errorf("can't compile title pattern '%s': %s", title, err.Error())
+		}
+
+		patterns = append(patterns, pattern)
+	}
+
+	return Rules{Labels: labels, Authors: authors, Titles: patterns}, nil
+}
+
+func (r Rules) IsEmpty() bool {
+	return len(r.Labels) == 0 && len(r.Authors) == 0 && len(r.Titles) == 0
+}
+
+func (r Rules) Merge(other Rules) Rules {
+	return Rules{
+		Labels:  append(append([]string{}, r.Labels...), other.Labels...),
+		Authors: append(append([]string{}, r.Authors...), other.Authors...),
+		Titles:  append(append([]*regexp.Regexp{}, r.Titles...), other.Titles...),
+	}
+}
+
+func (r Rules) Equal(other Rules) bool {
+	if !equalStrings(r.Labels, other.Labels) || !equalStrings(r.Authors, other.Authors) {
+		return false
+	}
+
+	if len(r.Titles) != len(other.Titles) {
+		return false
+	}
+
+	for index, title := range r.Titles {
+		if title.String() != other.Titles[index].String() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r Rules) match(issue *bugtracker.Issue) (string, bool) {
+	for _, label := range issue.Labels {
+		for _, ruleLabel := range r.Labels {
+			if strings.EqualFold(label, ruleLabel) {
+				return fmt.Sprintf("label '%s'", label), true
+			}
+		}
+	}
+
+	for _, ruleAuthor := range r.Authors {
+		if issue.Author.Login != "" && strings.EqualFold(issue.Author.Login, ruleAuthor) {
+			return fmt.Sprintf("author '%s'", issue.Author.Login), true
+		}
+	}
+
+	for _, title := range r.Titles {
+		if title.MatchString(issue.Subject) {
+			return fmt.Sprintf("title matching '%s'", title.String()), true
+		}
+	}
+
+	return "", false
+}
+
+func (f Filter) Equal(other Filter) bool {
+	return f.Include.Equal(other.Include) && f.Exclude.Equal(other.Exclude)
+}
+
+func (f Filter) Apply(issues []*bugtracker.Issue) ([]*bugtracker.Issue, []*Exclusion) {
+	var kept []*bugtracker.Issue
+	var excluded []*Exclusion
+
+	for _, issue := range issues {
+		if reason, ok := f.Exclude.match(issue); ok {
+			excluded = append(excluded, &Exclusion{Issue: issue, Reason: fmt.Sprintf("excluded by %s", reason)})
+			continue
+		}
+
+		if !f.Include.IsEmpty() {
+			if _, ok := f.Include.match(issue); !ok {
+				excluded = append(excluded, &Exclusion{Issue: issue, Reason: "not matching any include rule"})
+				continue
+			}
+		}
+
+		kept = append(kept, issue)
+	}
+
+	return kept, excluded
+}
+
+func equalStrings(values []string, others []string) bool {
+	if len(values) != len(others) {
+		return false
+	}
+
+	for index, value := range values {
+		if value != others[index] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,141 @@
+package filter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kdisneur/changelog/pkg/bugtracker"
+	"github.com/kdisneur/changelog/pkg/filter"
+)
+
+func TestNewRules(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Titles       []string
+		IsValid      bool
+		ErrorMessage string
+	}{
+		{
+			"When title patterns are valid",
+			[]string{"^chore", "(?i)wip"},
+			true,
+			"",
+		},
+		{
+			"When a title pattern is invalid",
+			[]string{"^chore", "(wip"},
+			false,
+			"can't compile title pattern '(wip'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			rules, err := filter.NewRules(nil, nil, testCase.Titles)
+
+			if err != nil && testCase.IsValid {
+				t.Fatalf("Expected no errors but got one. Received: %s", err.Error())
+			}
+
+			if err == nil && !testCase.IsValid {
+				t.Fatalf("Expected an error but got none. Received: %+v", rules)
+			}
+
+			if err != nil && !strings.Contains(err.Error(), testCase.ErrorMessage) {
+				t.Fatalf("Wrong error. Expected: %s. Received: %s", testCase.ErrorMessage, err.Error())
+			}
+		})
+	}
+}
+
+func TestFilterApply(t *testing.T) {
+	feature := &bugtracker.Issue{ID: "1", Subject: "Add a feature", Author: bugtracker.Author{Login: "johndoe"}, Labels: []string{"enhancement"}}
+	internal := &bugtracker.Issue{ID: "2", Subject: "Refactor the parser", Author: bugtracker.Author{Login: "janedoe"}, Labels: []string{"Internal"}}
+	bump := &bugtracker.Issue{ID: "3", Subject: "Bump cobra to v0.0.4", Author: bugtracker.Author{Login: "dependabot[bot]"}}
+	chore := &bugtracker.Issue{ID: "4", Subject: "chore: update README", Author: bugtracker.Author{Login: "johndoe"}}
+
+	mustRules := func(labels []string, authors []string, titles []string) filter.Rules {
+		rules, err := filter.NewRules(labels, authors, titles)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return rules
+	}
+
+	testCases := []struct {
+		Name             string
+		Filter           filter.Filter
+		ExpectedKept     []*bugtracker.Issue
+		ExpectedExcluded map[string]string
+	}{
+		{
+			"When filter has no rules",
+			filter.Filter{},
+			[]*bugtracker.Issue{feature, internal, bump, chore},
+			map[string]string{},
+		},
+		{
+			"When filter has exclude rules",
+			filter.Filter{
+				Exclude: mustRules([]string{"internal"}, []string{"dependabot[bot]"}, []string{"^chore"}),
+			},
+			[]*bugtracker.Issue{feature},
+			map[string]string{
+				"2": "excluded by label 'Internal'",
+				"3": "excluded by author 'dependabot[bot]'",
+				"4": "excluded by title matching '^chore'",
+			},
+		},
+		{
+			"When filter has include rules",
+			filter.Filter{
+				Include: mustRules([]string{"enhancement"}, []string{"janedoe"}, nil),
+			},
+			[]*bugtracker.Issue{feature, internal},
+			map[string]string{
+				"3": "not matching any include rule",
+				"4": "not matching any include rule",
+			},
+		},
+		{
+			"When filter has include and exclude rules",
+			filter.Filter{
+				Include: mustRules([]string{"enhancement"}, []string{"janedoe"}, nil),
+				Exclude: mustRules([]string{"internal"}, nil, nil),
+			},
+			[]*bugtracker.Issue{feature},
+			map[string]string{
+				"2": "excluded by label 'Internal'",
+				"3": "not matching any include rule",
+				"4": "not matching any include rule",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			kept, excluded := testCase.Filter.Apply([]*bugtracker.Issue{feature, internal, bump, chore})
+
+			if len(kept) != len(testCase.ExpectedKept) {
+				t.Fatalf("Wrong kept issues. Expected: %+v\nReceived: %+v", testCase.ExpectedKept, kept)
+			}
+
+			for index, issue := range testCase.ExpectedKept {
+				if !issue.Equal(kept[index]) {
+					t.Errorf("Wrong kept issue. Expected: %+v\nReceived: %+v", issue, kept[index])
+				}
+			}
+
+			if len(excluded) != len(testCase.ExpectedExcluded) {
+				t.Fatalf("Wrong excluded issues. Expected: %+v\nReceived: %+v", testCase.ExpectedExcluded, excluded)
+			}
+
+			for _, exclusion := range excluded {
+				if testCase.ExpectedExcluded[exclusion.Issue.ID] != exclusion.Reason {
+					t.Errorf("Wrong exclusion reason for #%s. Expected: %s\nReceived: %s", exclusion.Issue.ID, testCase.ExpectedExcluded[exclusion.Issue.ID], exclusion.Reason)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package formatter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+type atomFormatter struct{}
+
+type atomFeed struct {
+	XMLName   xml.Name    `xml:"feed"`
+	Namespace string      `xml:"xmlns,attr"`
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Updated   string      `xml:"updated"`
+	Author    atomAuthor  `xml:"author"`
+	Links     []atomLink  `xml:"link"`
+	Entries   []atomEntry `xml:"entry"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Content atomContent `xml:"content"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",cdata"`
+}
+
+func NewAtomFormatter() Formatter {
+	return &atomFormatter{}
+}
+
+func (a atomFormatter) Equal(other Formatter) bool {
+	_, hasGoodType := other.(*atomFormatter)
+
+	return hasGoodType
+}
+
+func (a atomFormatter) Format(newRelease *release.Release) string {
+	return FormatAtomFeed([]*release.Release{newRelease})
+}
+
+func FormatAtomFeed(releases []*release.Release) string {
+	feed := atomFeed{Namespace: "http://www.w3.org/2005/Atom"}
+
+	var updated time.Time
+	for _, release := range releases {
+		if feed.Title == "" {
+			feed.ID = feedID(release)
+			feed.Title = fmt.Sprintf("%s releases", release.Package.Name)
+			feed.Author = atomAuthor{Name: release.Package.Name}
+
+			if release.Repository != "" {
+				feed.Links = []atomLink{{Rel: "alternate", Href: release.Repository}}
+			}
+		}
+
+		if release.Date.After(updated) {
+			updated = release.Date
+		}
+
+		entry := atomEntry{
+			ID:      entryID(release),
+			Title:   release.VersionName,
+			Updated: release.Date.Format(time.RFC3339),
+			Content: atomContent{Type: "html", Body: formatHTMLRelease(release)},
+		}
+
+		if link := entryLink(release); link != "" {
+			entry.Links = []atomLink{{Rel: "alternate", Href: link}}
+		}
+
+		feed.Entries = append(feed.Entries, entry)
+	}
+	feed.Updated = updated.Format(time.RFC3339)
+
+	output, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return ""
+	}
+
+	return xml.Header + string(output) + "\n"
+}
+
+func feedID(release *release.Release) string {
+	if release.Repository != "" {
+		return release.Repository
+	}
+
+	return fmt.Sprintf("urn:changelog:%s", release.Package.Name)
+}
+
+func entryID(release *release.Release) string {
+	if release.Link != "" {
+		return release.Link
+	}
+
+	if release.Repository != "" {
+		return fmt.Sprintf("%s#%s", release.Repository, release.VersionName)
+	}
+
+	return fmt.Sprintf("urn:changelog:%s:%s", release.Package.Name, release.VersionName)
+}
+
+func entryLink(release *release.Release) string {
+	if release.CompareLink != "" {
+		return release.CompareLink
+	}
+
+	return release.Link
+}
@@ -0,0 +1,118 @@
+package formatter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kdisneur/changelog/pkg/bugtracker"
+	"github.com/kdisneur/changelog/pkg/formatter"
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+func TestFormatAtomFeed(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Releases []*release.Release
+		Expected string
+	}{
+		{
+			"When it contains several releases",
+			[]*release.Release{
+				{
+					VersionName: "v1.1.0",
+					Date:        time.Date(2018, time.November, 23, 0, 0, 0, 0, time.UTC),
+					Package:     release.Package{Name: "changelog"},
+					Repository:  "https://github.com/kdisneur/changelog",
+					Link:        "https://github.com/kdisneur/changelog/releases/tag/v1.1.0",
+					CompareLink: "https://github.com/kdisneur/changelog/compare/v1.0.0...v1.1.0",
+					Issues: []*bugtracker.Issue{
+						{ID: "42", Subject: "Escape <html>", Link: "https://github.com/kdisneur/changelog/pull/42"},
+					},
+				},
+				{
+					VersionName: "v1.0.0",
+					Date:        time.Date(2018, time.November, 19, 0, 0, 0, 0, time.UTC),
+					Package:     release.Package{Name: "changelog"},
+					Repository:  "https://github.com/kdisneur/changelog",
+					Link:        "https://github.com/kdisneur/changelog/releases/tag/v1.0.0",
+				},
+			},
+			`<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <id>https://github.com/kdisneur/changelog</id>
+  <title>changelog releases</title>
+  <updated>2018-11-23T00:00:00Z</updated>
+  <author>
+    <name>changelog</name>
+  </author>
+  <link rel="alternate" href="https://github.com/kdisneur/changelog"></link>
+  <entry>
+    <id>https://github.com/kdisneur/changelog/releases/tag/v1.1.0</id>
+    <title>v1.1.0</title>
+    <updated>2018-11-23T00:00:00Z</updated>
+    <link rel="alternate" href="https://github.com/kdisneur/changelog/compare/v1.0.0...v1.1.0"></link>
+    <content type="html"><![CDATA[<section class="release" id="v1.1.0">
+<h2><a class="anchor" href="#v1.1.0">#</a><a href="https://github.com/kdisneur/changelog/compare/v1.0.0...v1.1.0">v1.1.0</a> <time datetime="2018-11-23">2018-11-23</time></h2>
+<h3>Changes</h3>
+<ul>
+<li>Escape &lt;html&gt; <span class="reference">(<a href="https://github.com/kdisneur/changelog/pull/42">#42</a>)</span></li>
+</ul>
+</section>
+]]></content>
+  </entry>
+  <entry>
+    <id>https://github.com/kdisneur/changelog/releases/tag/v1.0.0</id>
+    <title>v1.0.0</title>
+    <updated>2018-11-19T00:00:00Z</updated>
+    <link rel="alternate" href="https://github.com/kdisneur/changelog/releases/tag/v1.0.0"></link>
+    <content type="html"><![CDATA[<section class="release" id="v1.0.0">
+<h2><a class="anchor" href="#v1.0.0">#</a>v1.0.0 <time datetime="2018-11-19">2018-11-19</time></h2>
+<p>No changes</p>
+</section>
+]]></content>
+  </entry>
+</feed>
+`,
+		},
+		{
+			"When the release has no links",
+			[]*release.Release{
+				{
+					VersionName: "v1.0.0",
+					Date:        time.Date(2018, time.November, 19, 0, 0, 0, 0, time.UTC),
+					Package:     release.Package{Name: "changelog"},
+				},
+			},
+			`<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <id>urn:changelog:changelog</id>
+  <title>changelog releases</title>
+  <updated>2018-11-19T00:00:00Z</updated>
+  <author>
+    <name>changelog</name>
+  </author>
+  <entry>
+    <id>urn:changelog:changelog:v1.0.0</id>
+    <title>v1.0.0</title>
+    <updated>2018-11-19T00:00:00Z</updated>
+    <content type="html"><![CDATA[<section class="release" id="v1.0.0">
+<h2><a class="anchor" href="#v1.0.0">#</a>v1.0.0 <time datetime="2018-11-19">2018-11-19</time></h2>
+<p>No changes</p>
+</section>
+]]></content>
+  </entry>
+</feed>
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			actual := formatter.FormatAtomFeed(testCase.Releases)
+
+			if actual != testCase.Expected {
+				t.Fatalf("Wrong output. Expected:\n%s\nReceived:\n%s", testCase.Expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package formatter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var formatters = map[string]func() Formatter{
+	"atom":      NewAtomFormatter,
+	"debian":    NewDebianFormatter,
+	"html":      NewHTMLFormatter,
+	"html-page": NewHTMLPageFormatter,
+	"json":      NewJSONFormatter,
+	"markdown":  NewMarkdownFormatter,
+	"rpm":       NewRPMFormatter,
+	"slack":     NewSlackFormatter,
+	"teams":     NewTeamsFormatter,
+}
+
+func New(name string) (Formatter, error) {
+	newFormatter, ok := formatters[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown format '%s', expected one of: %s", name, strings.Join(Names(), ", "))
+	}
+
+	return newFormatter(), nil
+}
+
+func Names() []string {
+	var names []string
+	for name := range formatters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,182 @@
+package formatter
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/kdisneur/changelog/pkg/bugtracker"
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+var anchorInvalidCharacters = regexp.MustCompile(`[^a-z0-9._-]+`)
+
+const htmlStyle = `body{margin:0;background:#fff;color:#24292e;font:16px/1.5 -apple-system,BlinkMacSystemFont,"Segoe UI",Helvetica,Arial,sans-serif}
+main{max-width:46em;margin:0 auto;padding:2em 1em}
+h2{margin:0 0 .5em;padding-bottom:.3em;border-bottom:1px solid #eaecef}
+h2 time{color:#6a737d;font-size:.75em;font-weight:normal}
+h3{margin:1.5em 0 .5em;font-size:1.1em}
+ul{margin:0;padding-left:1.5em}
+li{margin:.25em 0}
+a{color:#0366d6;text-decoration:none}
+a:hover{text-decoration:underline}
+.anchor{margin-left:-1em;padding-right:.25em;color:#d1d5da;visibility:hidden}
+h2:hover .anchor{visibility:visible}
+.reference,.first-contribution{color:#6a737d}`
+
+type htmlFormatter struct {
+	standalone bool
+}
+
+func NewHTMLFormatter() Formatter {
+	return &htmlFormatter{}
+}
+
+func NewHTMLPageFormatter() Formatter {
+	return &htmlFormatter{standalone: true}
+}
+
+func (h htmlFormatter) Equal(other Formatter) bool {
+	otherHTML, hasGoodType := other.(*htmlFormatter)
+
+	return hasGoodType && h.standalone == otherHTML.standalone
+}
+
+func (h htmlFormatter) Format(release *release.Release) string {
+	fragment := formatHTMLRelease(release)
+	if !h.standalone {
+		return fragment
+	}
+
+	return fmt.Sprintf(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Release notes %s</title>
+<style>
+%s
+</style>
+</head>
+<body>
+<main>
+%s</main>
+</body>
+</html>
+`, html.EscapeString(release.VersionName), htmlStyle, fragment)
+}
+
+func formatHTMLRelease(release *release.Release) string {
+	var output bytes.Buffer
+
+	anchor := htmlAnchor(release.VersionName)
+	output.WriteString(fmt.Sprintf("<section class=\"release\" id=\"%s\">\n", anchor))
+	output.WriteString(fmt.Sprintf("<h2><a class=\"anchor\" href=\"#%s\">#</a>%s</h2>\n", anchor, formatHTMLHeading(release)))
+
+	if len(release.Issues) == 0 && len(release.DirectCommits) == 0 && len(release.Reverted) == 0 {
+		output.WriteString("<p>No changes</p>\n")
+	}
+
+	if len(release.Issues) > 0 {
+		output.WriteString("<h3>Changes</h3>\n<ul>\n")
+		for _, issue := range release.Issues {
+			output.WriteString(fmt.Sprintf("<li>%s %s</li>\n", formatHTMLSubject(issue.Subject), formatHTMLReferences(issue)))
+		}
+		output.WriteString("</ul>\n")
+	}
+
+	if len(release.DirectCommits) > 0 {
+		output.WriteString("<h3>Other changes</h3>\n<ul>\n")
+		for _, commit := range release.DirectCommits {
+			reference := htmlLink(commit.Commit.ShortID(), commit.Link)
+			output.WriteString(fmt.Sprintf("<li>%s <span class=\"reference\">(%s)</span></li>\n", formatHTMLSubject(commit.Commit.Message), reference))
+		}
+		output.WriteString("</ul>\n")
+	}
+
+	if len(release.Reverted) > 0 {
+		output.WriteString("<h3>Reverted</h3>\n<ul>\n")
+		for _, issue := range release.Reverted {
+			reference := htmlLink(issueLabel(issue.ID), issue.Link)
+			output.WriteString(fmt.Sprintf("<li>%s <span class=\"reference\">(%s)</span></li>\n", formatHTMLSubject(issue.Subject), reference))
+		}
+		output.WriteString("</ul>\n")
+	}
+
+	output.WriteString(formatHTMLContributors(release.Contributors))
+	output.WriteString("</section>\n")
+
+	return output.String()
+}
+
+func formatHTMLHeading(release *release.Release) string {
+	versionName := htmlLink(release.VersionName, release.CompareLink)
+	if release.Unreleased {
+		return versionName
+	}
+
+	date := formatReleaseDate(release.Date)
+
+	return fmt.Sprintf("%s <time datetime=\"%s\">%s</time>", versionName, date, date)
+}
+
+func formatHTMLSubject(subject string) string {
+	return strings.Replace(html.EscapeString(strings.TrimSpace(subject)), "\n", "<br>\n", -1)
+}
+
+func formatHTMLReferences(issue *bugtracker.Issue) string {
+	references := htmlLink(issueLabel(issue.ID), issue.Link)
+
+	if len(issue.LinkedIssues) > 0 {
+		var labels []string
+		for _, linkedIssue := range issue.LinkedIssues {
+			labels = append(labels, htmlLink(linkedIssue.Label(), linkedIssue.Link))
+		}
+
+		references = fmt.Sprintf("%s, closes %s", references, strings.Join(labels, ", "))
+	}
+
+	return fmt.Sprintf("<span class=\"reference\">(%s)</span>", references)
+}
+
+func formatHTMLContributors(contributors []*release.Contributor) string {
+	if len(contributors) == 0 {
+		return ""
+	}
+
+	var list bytes.Buffer
+
+	list.WriteString("<h3>Contributors</h3>\n<ul>\n")
+	for _, contributor := range contributors {
+		name := contributor.Name
+		if contributor.Login != "" {
+			name = fmt.Sprintf("@%s", contributor.Login)
+		}
+
+		name = htmlLink(name, contributor.Link)
+		if contributor.FirstTime {
+			name = fmt.Sprintf("%s <span class=\"first-contribution\">(first contribution)</span>", name)
+		}
+
+		list.WriteString(fmt.Sprintf("<li>%s</li>\n", name))
+	}
+	list.WriteString("</ul>\n")
+
+	return list.String()
+}
+
+func htmlLink(label string, link string) string {
+	if link == "" {
+		return html.EscapeString(label)
+	}
+
+	return fmt.Sprintf("<a href=\"%s\">%s</a>", html.EscapeString(link), html.EscapeString(label))
+}
+
+func htmlAnchor(versionName string) string {
+	anchor := anchorInvalidCharacters.ReplaceAllString(strings.ToLower(versionName), "-")
+
+	return strings.Trim(anchor, "-")
+}
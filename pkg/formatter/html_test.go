@@ -0,0 +1,130 @@
+package formatter_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kdisneur/changelog/pkg/bugtracker"
+	"github.com/kdisneur/changelog/pkg/formatter"
+	"github.com/kdisneur/changelog/pkg/git"
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+func TestHTMLFormatter(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Release  *release.Release
+		Expected string
+	}{
+		{
+			"When it contains several sections",
+			&release.Release{
+				VersionName: "v1.0.0",
+				Date:        time.Date(2018, time.November, 19, 5, 12, 42, 0, time.UTC),
+				CompareLink: "https://github.com/kdisneur/changelog/compare/v0.9.0...v1.0.0",
+				Issues: []*bugtracker.Issue{
+					{ID: "42", Subject: "Render <script> & \"quotes\"", Link: "https://github.com/kdisneur/changelog/pull/42"},
+					{ID: "1337", Subject: "Another nice feature", Link: "https://github.com/kdisneur/changelog/pull/1337", LinkedIssues: []bugtracker.LinkedIssue{{ID: "10", Link: "https://github.com/kdisneur/changelog/issues/10"}}},
+				},
+				DirectCommits: []*release.Commit{
+					{Commit: &git.Commit{ID: "a1b2c3d4e5f60718293a4b5c6d7e8f9012345678", Message: "Fix production crash"}, Link: "https://github.com/kdisneur/changelog/commit/a1b2c3d4e5f60718293a4b5c6d7e8f9012345678"},
+				},
+				Reverted: []*bugtracker.Issue{
+					{ID: "7", Subject: "A broken feature"},
+				},
+				Contributors: []*release.Contributor{
+					{Login: "janedoe", Link: "https://github.com/janedoe", FirstTime: true},
+					{Name: "John Doe"},
+				},
+			},
+			`<section class="release" id="v1.0.0">
+<h2><a class="anchor" href="#v1.0.0">#</a><a href="https://github.com/kdisneur/changelog/compare/v0.9.0...v1.0.0">v1.0.0</a> <time datetime="2018-11-19">2018-11-19</time></h2>
+<h3>Changes</h3>
+<ul>
+<li>Render &lt;script&gt; &amp; &#34;quotes&#34; <span class="reference">(<a href="https://github.com/kdisneur/changelog/pull/42">#42</a>)</span></li>
+<li>Another nice feature <span class="reference">(<a href="https://github.com/kdisneur/changelog/pull/1337">#1337</a>, closes <a href="https://github.com/kdisneur/changelog/issues/10">#10</a>)</span></li>
+</ul>
+<h3>Other changes</h3>
+<ul>
+<li>Fix production crash <span class="reference">(<a href="https://github.com/kdisneur/changelog/commit/a1b2c3d4e5f60718293a4b5c6d7e8f9012345678">a1b2c3d</a>)</span></li>
+</ul>
+<h3>Reverted</h3>
+<ul>
+<li>A broken feature <span class="reference">(#7)</span></li>
+</ul>
+<h3>Contributors</h3>
+<ul>
+<li><a href="https://github.com/janedoe">@janedoe</a> <span class="first-contribution">(first contribution)</span></li>
+<li>John Doe</li>
+</ul>
+</section>
+`,
+		},
+		{
+			"When it contains a multi-line subject",
+			&release.Release{
+				VersionName: "v1.0.0",
+				Date:        time.Date(2018, time.November, 19, 5, 12, 42, 0, time.UTC),
+				Issues: []*bugtracker.Issue{
+					{ID: "42", Subject: "A nice feature:\n- with details", Link: "https://github.com/kdisneur/changelog/pull/42"},
+				},
+			},
+			`<section class="release" id="v1.0.0">
+<h2><a class="anchor" href="#v1.0.0">#</a>v1.0.0 <time datetime="2018-11-19">2018-11-19</time></h2>
+<h3>Changes</h3>
+<ul>
+<li>A nice feature:<br>
+- with details <span class="reference">(<a href="https://github.com/kdisneur/changelog/pull/42">#42</a>)</span></li>
+</ul>
+</section>
+`,
+		},
+		{
+			"When it is the unreleased section without changes",
+			&release.Release{
+				VersionName: "Unreleased",
+				Date:        time.Date(2018, time.November, 19, 5, 12, 42, 0, time.UTC),
+				Unreleased:  true,
+			},
+			`<section class="release" id="unreleased">
+<h2><a class="anchor" href="#unreleased">#</a>Unreleased</h2>
+<p>No changes</p>
+</section>
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			actual := formatter.NewHTMLFormatter().Format(testCase.Release)
+
+			if actual != testCase.Expected {
+				t.Fatalf("Wrong output. Expected:\n%s\nReceived:\n%s", testCase.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestHTMLPageFormatter(t *testing.T) {
+	newRelease := &release.Release{
+		VersionName: "v1.0.0 <beta>",
+		Date:        time.Date(2018, time.November, 19, 5, 12, 42, 0, time.UTC),
+		Issues: []*bugtracker.Issue{
+			{ID: "42", Subject: "A nice feature", Link: "https://github.com/kdisneur/changelog/pull/42"},
+		},
+	}
+
+	actual := formatter.NewHTMLPageFormatter().Format(newRelease)
+	fragment := formatter.NewHTMLFormatter().Format(newRelease)
+
+	for _, expected := range []string{"<!DOCTYPE html>\n", "<title>Release notes v1.0.0 &lt;beta&gt;</title>", "<style>\n", "<main>\n" + fragment + "</main>", "</html>\n"} {
+		if !strings.Contains(actual, expected) {
+			t.Errorf("Expected the page to contain:\n%s\nReceived:\n%s", expected, actual)
+		}
+	}
+
+	if !strings.Contains(fragment, `id="v1.0.0-beta"`) {
+		t.Errorf("Wrong anchor. Received:\n%s", fragment)
+	}
+}
@@ -0,0 +1,116 @@
+package formatter
+
+import (
+	"encoding/json"
+
+	"github.com/kdisneur/changelog/pkg/bugtracker"
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+type jsonFormatter struct{}
+
+type jsonRelease struct {
+	Version      string            `json:"version"`
+	Date         string            `json:"date"`
+	Unreleased   bool              `json:"unreleased,omitempty"`
+	CompareLink  string            `json:"compareLink,omitempty"`
+	Issues       []jsonIssue       `json:"issues"`
+	OtherChanges []jsonCommit      `json:"otherChanges,omitempty"`
+	Reverted     []jsonIssue       `json:"reverted,omitempty"`
+	Contributors []jsonContributor `json:"contributors,omitempty"`
+}
+
+type jsonIssue struct {
+	ID           string            `json:"id"`
+	Subject      string            `json:"subject"`
+	Link         string            `json:"link"`
+	Author       string            `json:"author,omitempty"`
+	Labels       []string          `json:"labels,omitempty"`
+	LinkedIssues []jsonLinkedIssue `json:"linkedIssues,omitempty"`
+}
+
+type jsonLinkedIssue struct {
+	ID         string `json:"id"`
+	Repository string `json:"repository,omitempty"`
+	Link       string `json:"link"`
+}
+
+type jsonCommit struct {
+	ID      string `json:"id"`
+	Subject string `json:"subject"`
+	Link    string `json:"link,omitempty"`
+}
+
+type jsonContributor struct {
+	Login     string `json:"login,omitempty"`
+	Name      string `json:"name"`
+	Link      string `json:"link,omitempty"`
+	FirstTime bool   `json:"firstTime"`
+}
+
+func NewJSONFormatter() Formatter {
+	return &jsonFormatter{}
+}
+
+func (j jsonFormatter) Equal(other Formatter) bool {
+	_, hasGoodType := other.(*jsonFormatter)
+
+	return hasGoodType
+}
+
+func (j jsonFormatter) Format(release *release.Release) string {
+	output := jsonRelease{
+		Version:     release.VersionName,
+		Date:        formatReleaseDate(release.Date),
+		Unreleased:  release.Unreleased,
+		CompareLink: release.CompareLink,
+		Issues:      toJSONIssues(release.Issues),
+	}
+
+	for _, commit := range release.DirectCommits {
+		output.OtherChanges = append(output.OtherChanges, jsonCommit{ID: commit.Commit.ID, Subject: commit.Commit.Message, Link: commit.Link})
+	}
+
+	if len(release.Reverted) > 0 {
+		output.Reverted = toJSONIssues(release.Reverted)
+	}
+
+	for _, contributor := range release.Contributors {
+		output.Contributors = append(output.Contributors, jsonContributor{
+			Login:     contributor.Login,
+			Name:      contributor.Name,
+			Link:      contributor.Link,
+			FirstTime: contributor.FirstTime,
+		})
+	}
+
+	content, _ := json.MarshalIndent(output, "", "  ")
+
+	return string(content)
+}
+
+func toJSONIssues(issues []*bugtracker.Issue) []jsonIssue {
+	jsonIssues := make([]jsonIssue, 0, len(issues))
+
+	for _, issue := range issues {
+		jsonIssue := jsonIssue{
+			ID:      issue.ID,
+			Subject: issue.Subject,
+			Link:    issue.Link,
+			Author:  issue.Author.Login,
+			Labels:  issue.Labels,
+		}
+
+		for _, linkedIssue := range issue.LinkedIssues {
+			jsonIssue.LinkedIssues = append(jsonIssue.LinkedIssues, jsonLinkedIssue{
+				ID:         linkedIssue.ID,
+				Repository: linkedIssue.Repository,
+				Link:       linkedIssue.Link,
+			})
+		}
+
+		jsonIssues = append(jsonIssues, jsonIssue)
+	}
+
+	return jsonIssues
+}
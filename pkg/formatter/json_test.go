@@ -0,0 +1,100 @@
+package formatter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kdisneur/changelog/pkg/bugtracker"
+	"github.com/kdisneur/changelog/pkg/formatter"
+	"github.com/kdisneur/changelog/pkg/git"
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+func TestJSONFormatter(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Release  *release.Release
+		Expected string
+	}{
+		{
+			"When it contains several issues",
+			&release.Release{
+				VersionName: "v1.0.0",
+				Date:        time.Date(2018, time.November, 19, 5, 12, 42, 0, time.UTC),
+				Issues: []*bugtracker.Issue{
+					{ID: "42", Subject: "A nice feature", Link: "https://github.com/kdisneur/changelog/pull/42", Author: bugtracker.Author{Login: "johndoe"}, Labels: []string{"enhancement"}},
+					{ID: "1337", Subject: "Another nice feature", Link: "https://github.com/kdisneur/changelog/pull/1337", LinkedIssues: []bugtracker.LinkedIssue{{ID: "10", Link: "https://github.com/kdisneur/changelog/issues/10"}}},
+				},
+				DirectCommits: []*release.Commit{
+					{Commit: &git.Commit{ID: "a1b2c3d4e5f60718293a4b5c6d7e8f9012345678", Message: "Fix production crash"}, Link: "https://github.com/kdisneur/changelog/commit/a1b2c3d4e5f60718293a4b5c6d7e8f9012345678"},
+				},
+				Contributors: []*release.Contributor{
+					{Login: "johndoe", Name: "John Doe", Link: "https://github.com/johndoe", FirstTime: true},
+				},
+			},
+			`{
+  "version": "v1.0.0",
+  "date": "2018-11-19",
+  "issues": [
+    {
+      "id": "42",
+      "subject": "A nice feature",
+      "link": "https://github.com/kdisneur/changelog/pull/42",
+      "author": "johndoe",
+      "labels": [
+        "enhancement"
+      ]
+    },
+    {
+      "id": "1337",
+      "subject": "Another nice feature",
+      "link": "https://github.com/kdisneur/changelog/pull/1337",
+      "linkedIssues": [
+        {
+          "id": "10",
+          "link": "https://github.com/kdisneur/changelog/issues/10"
+        }
+      ]
+    }
+  ],
+  "otherChanges": [
+    {
+      "id": "a1b2c3d4e5f60718293a4b5c6d7e8f9012345678",
+      "subject": "Fix production crash",
+      "link": "https://github.com/kdisneur/changelog/commit/a1b2c3d4e5f60718293a4b5c6d7e8f9012345678"
+    }
+  ],
+  "contributors": [
+    {
+      "login": "johndoe",
+      "name": "John Doe",
+      "link": "https://github.com/johndoe",
+      "firstTime": true
+    }
+  ]
+}`,
+		},
+		{
+			"When it contains no issues",
+			&release.Release{
+				VersionName: "v1.0.0",
+				Date:        time.Date(2018, time.November, 19, 5, 12, 42, 0, time.UTC),
+			},
+			`{
+  "version": "v1.0.0",
+  "date": "2018-11-19",
+  "issues": []
+}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			actual := formatter.NewJSONFormatter().Format(testCase.Release)
+
+			if actual != testCase.Expected {
+				t.Fatalf("Wrong output. Expected:\n%s\nReceived:\n%s", testCase.Expected, actual)
+			}
+		})
+	}
+}
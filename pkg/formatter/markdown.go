@@ -3,9 +3,12 @@ package formatter
 import (
 	"bytes"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/kdisneur/changelog/pkg/bugtracker"
+	"github.com/kdisneur/changelog/pkg/release"
 )
 
 type markdownFormatter struct{}
@@ -20,27 +23,139 @@ func (m markdownFormatter) Equal(other Formatter) bool {
 	return hasGoodType
 }
 
-func (m markdownFormatter) FormatIssues(versionName string, releaseDate time.Time, issues []*bugtracker.Issue) string {
-	if len(issues) == 0 {
-		return formatNoIssues(versionName, releaseDate)
+func (m markdownFormatter) Format(release *release.Release) string {
+	if len(release.Issues) == 0 && len(release.DirectCommits) == 0 && len(release.Reverted) == 0 {
+		return formatNoIssues(release)
 	} else {
-		return formatIssues(versionName, releaseDate, issues)
+		return formatIssues(release)
 	}
 }
 
-func formatIssues(versionName string, releaseDate time.Time, issues []*bugtracker.Issue) string {
+func formatIssues(release *release.Release) string {
 	var list bytes.Buffer
 	var links bytes.Buffer
 
-	for _, issue := range issues {
-		list.WriteString(fmt.Sprintf("- %s ([#%s])\n", issue.Subject, issue.ID))
-		links.WriteString(fmt.Sprintf("[#%s]: %s\n", issue.ID, issue.Link))
+	writtenLinks := make(map[string]bool)
+	reference := func(label string, link string) string {
+		if link == "" {
+			return label
+		}
+
+		if !writtenLinks[label] {
+			writtenLinks[label] = true
+			links.WriteString(fmt.Sprintf("[%s]: %s\n", label, link))
+		}
+
+		return fmt.Sprintf("[%s]", label)
+	}
+
+	for _, issue := range release.Issues {
+		issueReference := reference(issueLabel(issue.ID), issue.Link)
+		list.WriteString(fmt.Sprintf("- %s (%s%s)\n", indentSubject(issue.Subject), issueReference, formatLinkedIssues(issue.LinkedIssues, reference)))
+	}
+
+	if list.Len() > 0 {
+		list.WriteString("\n")
+	}
+
+	if len(release.DirectCommits) > 0 {
+		list.WriteString("### Other changes\n\n")
+		for _, commit := range release.DirectCommits {
+			list.WriteString(fmt.Sprintf("- %s (%s)\n", indentSubject(commit.Commit.Message), reference(commit.Commit.ShortID(), commit.Link)))
+		}
+		list.WriteString("\n")
+	}
+
+	if len(release.Reverted) > 0 {
+		list.WriteString("### Reverted\n\n")
+		for _, issue := range release.Reverted {
+			list.WriteString(fmt.Sprintf("- %s (%s)\n", indentSubject(issue.Subject), reference(issueLabel(issue.ID), issue.Link)))
+		}
+		list.WriteString("\n")
+	}
+
+	return fmt.Sprintf("%s\n\n%s%s%s", formatHeading(release), list.String(), formatContributors(release.Contributors), links.String())
+}
+
+func issueLabel(id string) string {
+	if _, err := strconv.Atoi(id); err != nil {
+		return id
+	}
+
+	return fmt.Sprintf("#%s", id)
+}
+
+func formatLinkedIssues(linkedIssues []bugtracker.LinkedIssue, reference func(label string, link string) string) string {
+	if len(linkedIssues) == 0 {
+		return ""
+	}
+
+	var labels []string
+	for _, linkedIssue := range linkedIssues {
+		labels = append(labels, reference(linkedIssue.Label(), linkedIssue.Link))
+	}
+
+	return fmt.Sprintf(", closes %s", strings.Join(labels, ", "))
+}
+
+func indentSubject(subject string) string {
+	lines := strings.Split(subject, "\n")
+
+	for index, line := range lines[1:] {
+		if line != "" {
+			lines[index+1] = fmt.Sprintf("  %s", line)
+		}
 	}
 
-	return fmt.Sprintf("## %s - %s\n\n%s\n%s", versionName, formatReleaseDate(releaseDate), list.String(), links.String())
+	return strings.Join(lines, "\n")
 }
-func formatNoIssues(versionName string, releaseDate time.Time) string {
-	return fmt.Sprintf("## %s - %s\n\n(No changes)\n", versionName, formatReleaseDate(releaseDate))
+
+func formatContributors(contributors []*release.Contributor) string {
+	if len(contributors) == 0 {
+		return ""
+	}
+
+	var list bytes.Buffer
+
+	list.WriteString("### Contributors\n\n")
+	for _, contributor := range contributors {
+		name := contributor.Name
+		if contributor.Login != "" {
+			name = fmt.Sprintf("@%s", contributor.Login)
+		}
+
+		if contributor.Link != "" {
+			name = fmt.Sprintf("[%s](%s)", name, contributor.Link)
+		}
+
+		if contributor.FirstTime {
+			list.WriteString(fmt.Sprintf("- %s (first contribution)\n", name))
+		} else {
+			list.WriteString(fmt.Sprintf("- %s\n", name))
+		}
+	}
+	list.WriteString("\n")
+
+	return list.String()
+}
+
+func formatNoIssues(release *release.Release) string {
+	return fmt.Sprintf("%s\n\n(No changes)\n", formatHeading(release))
+}
+
+func formatHeading(release *release.Release) string {
+	versionName := release.VersionName
+	if release.CompareLink != "" {
+		versionName = fmt.Sprintf("[%s](%s)", release.VersionName, release.CompareLink)
+	} else if release.Unreleased {
+		versionName = fmt.Sprintf("[%s]", release.VersionName)
+	}
+
+	if release.Unreleased {
+		return fmt.Sprintf("## %s", versionName)
+	}
+
+	return fmt.Sprintf("## %s - %s", versionName, formatReleaseDate(release.Date))
 }
 
 func formatReleaseDate(date time.Time) string {
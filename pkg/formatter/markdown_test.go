@@ -6,15 +6,17 @@ import (
 
 	"github.com/kdisneur/changelog/pkg/bugtracker"
 	"github.com/kdisneur/changelog/pkg/formatter"
+	"github.com/kdisneur/changelog/pkg/release"
 )
 
 func TestMardownFormatter(t *testing.T) {
 	testCases := []struct {
-		Name     string
-		Version  string
-		Date     time.Time
-		Issues   []*bugtracker.Issue
-		Expected string
+		Name         string
+		Version      string
+		Date         time.Time
+		Issues       []*bugtracker.Issue
+		Contributors []*release.Contributor
+		Expected     string
 	}{
 		{
 			"When it contains several issues",
@@ -24,6 +26,7 @@ func TestMardownFormatter(t *testing.T) {
 				&bugtracker.Issue{ID: "42", Subject: "A nice feature", Link: "https://github.com/kdisneur/changelog/pull/42"},
 				&bugtracker.Issue{ID: "1337", Subject: "Another nice feature", Link: "https://github.com/kdisneur/changelog/pull/1337"},
 			},
+			nil,
 			`## v1.0.0 - 2018-11-19
 
 - A nice feature ([#42])
@@ -40,11 +43,86 @@ func TestMardownFormatter(t *testing.T) {
 			[]*bugtracker.Issue{
 				&bugtracker.Issue{ID: "42", Subject: "A nice feature", Link: "https://github.com/kdisneur/changelog/pull/42"},
 			},
+			nil,
 			`## v1.0.0 - 2018-11-19
 
 - A nice feature ([#42])
 
 [#42]: https://github.com/kdisneur/changelog/pull/42
+`,
+		},
+		{
+			"When it contains a multi-line subject",
+			"v1.0.0",
+			time.Date(2018, time.November, 19, 5, 12, 42, 0, time.UTC),
+			[]*bugtracker.Issue{
+				&bugtracker.Issue{ID: "42", Subject: "A nice feature:\n\n- with a list\n- of details", Link: "https://github.com/kdisneur/changelog/pull/42"},
+			},
+			nil,
+			`## v1.0.0 - 2018-11-19
+
+- A nice feature:
+
+  - with a list
+  - of details ([#42])
+
+[#42]: https://github.com/kdisneur/changelog/pull/42
+`,
+		},
+		{
+			"When it contains linked issues",
+			"v1.0.0",
+			time.Date(2018, time.November, 19, 5, 12, 42, 0, time.UTC),
+			[]*bugtracker.Issue{
+				&bugtracker.Issue{
+					ID:      "42",
+					Subject: "A nice feature",
+					Link:    "https://github.com/kdisneur/changelog/pull/42",
+					LinkedIssues: []bugtracker.LinkedIssue{
+						{ID: "10", Link: "https://github.com/kdisneur/changelog/issues/10"},
+						{ID: "3", Repository: "kdisneur/website", Link: "https://github.com/kdisneur/website/issues/3"},
+					},
+				},
+				&bugtracker.Issue{
+					ID:      "1337",
+					Subject: "Another nice feature",
+					Link:    "https://github.com/kdisneur/changelog/pull/1337",
+					LinkedIssues: []bugtracker.LinkedIssue{
+						{ID: "10", Link: "https://github.com/kdisneur/changelog/issues/10"},
+					},
+				},
+			},
+			nil,
+			`## v1.0.0 - 2018-11-19
+
+- A nice feature ([#42], closes [#10], [kdisneur/website#3])
+- Another nice feature ([#1337], closes [#10])
+
+[#42]: https://github.com/kdisneur/changelog/pull/42
+[#10]: https://github.com/kdisneur/changelog/issues/10
+[kdisneur/website#3]: https://github.com/kdisneur/website/issues/3
+[#1337]: https://github.com/kdisneur/changelog/pull/1337
+`,
+		},
+		{
+			"When it contains issues without links",
+			"v1.0.0",
+			time.Date(2018, time.November, 19, 5, 12, 42, 0, time.UTC),
+			[]*bugtracker.Issue{
+				&bugtracker.Issue{
+					ID:           "42",
+					Subject:      "A nice feature",
+					LinkedIssues: []bugtracker.LinkedIssue{{ID: "10"}},
+				},
+				&bugtracker.Issue{ID: "1337", Subject: "Another nice feature", Link: "https://github.com/kdisneur/changelog/pull/1337"},
+			},
+			nil,
+			`## v1.0.0 - 2018-11-19
+
+- A nice feature (#42, closes #10)
+- Another nice feature ([#1337])
+
+[#1337]: https://github.com/kdisneur/changelog/pull/1337
 `,
 		},
 		{
@@ -52,9 +130,33 @@ func TestMardownFormatter(t *testing.T) {
 			"v1.0.0",
 			time.Date(2018, time.November, 19, 5, 12, 42, 0, time.UTC),
 			[]*bugtracker.Issue{},
+			nil,
 			`## v1.0.0 - 2018-11-19
 
 (No changes)
+`,
+		},
+		{
+			"When it contains contributors",
+			"v1.0.0",
+			time.Date(2018, time.November, 19, 5, 12, 42, 0, time.UTC),
+			[]*bugtracker.Issue{
+				&bugtracker.Issue{ID: "42", Subject: "A nice feature", Link: "https://github.com/kdisneur/changelog/pull/42"},
+			},
+			[]*release.Contributor{
+				&release.Contributor{Login: "janedoe", Link: "https://github.com/janedoe", FirstTime: true},
+				&release.Contributor{Name: "John Doe"},
+			},
+			`## v1.0.0 - 2018-11-19
+
+- A nice feature ([#42])
+
+### Contributors
+
+- [@janedoe](https://github.com/janedoe) (first contribution)
+- John Doe
+
+[#42]: https://github.com/kdisneur/changelog/pull/42
 `,
 		},
 	}
@@ -62,11 +164,12 @@ func TestMardownFormatter(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			markdown := formatter.NewMarkdownFormatter()
-			actual := markdown.FormatIssues(
-				testCase.Version,
-				testCase.Date,
-				testCase.Issues,
-			)
+			actual := markdown.Format(&release.Release{
+				VersionName:  testCase.Version,
+				Date:         testCase.Date,
+				Issues:       testCase.Issues,
+				Contributors: testCase.Contributors,
+			})
 
 			if actual != testCase.Expected {
 				t.Errorf("Wrong format.\nExpected:\n%s\n\nReceived:\n%s", testCase.Expected, actual)
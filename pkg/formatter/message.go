@@ -0,0 +1,111 @@
+package formatter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kdisneur/changelog/pkg/bugtracker"
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+type messageCategory struct {
+	title string
+	lines []string
+}
+
+type messageStyle struct {
+	bullet  string
+	link    func(label string, link string) string
+	subject func(subject string) string
+}
+
+func messageCategories(release *release.Release, style messageStyle) []messageCategory {
+	var categories []messageCategory
+
+	if len(release.Issues) > 0 {
+		categories = append(categories, messageCategory{"Changes", messageIssueLines(release.Issues, style)})
+	}
+
+	if len(release.DirectCommits) > 0 {
+		var lines []string
+		for _, commit := range release.DirectCommits {
+			lines = append(lines, fmt.Sprintf("%s %s (%s)", style.bullet, style.subject(commit.Commit.Message), style.link(commit.Commit.ShortID(), commit.Link)))
+		}
+
+		categories = append(categories, messageCategory{"Other changes", lines})
+	}
+
+	if len(release.Reverted) > 0 {
+		categories = append(categories, messageCategory{"Reverted", messageIssueLines(release.Reverted, style)})
+	}
+
+	if len(release.Contributors) > 0 {
+		var lines []string
+		for _, contributor := range release.Contributors {
+			name := contributor.Name
+			if contributor.Login != "" {
+				name = fmt.Sprintf("@%s", contributor.Login)
+			}
+
+			line := fmt.Sprintf("%s %s", style.bullet, style.link(name, contributor.Link))
+			if contributor.FirstTime {
+				line = fmt.Sprintf("%s (first contribution)", line)
+			}
+
+			lines = append(lines, line)
+		}
+
+		categories = append(categories, messageCategory{"Contributors", lines})
+	}
+
+	return categories
+}
+
+func messageIssueLines(issues []*bugtracker.Issue, style messageStyle) []string {
+	var lines []string
+
+	for _, issue := range issues {
+		reference := style.link(issueLabel(issue.ID), issue.Link)
+
+		var linkedIssues []string
+		for _, linkedIssue := range issue.LinkedIssues {
+			linkedIssues = append(linkedIssues, style.link(linkedIssue.Label(), linkedIssue.Link))
+		}
+
+		if len(linkedIssues) > 0 {
+			reference = fmt.Sprintf("%s, closes %s", reference, strings.Join(linkedIssues, ", "))
+		}
+
+		lines = append(lines, fmt.Sprintf("%s %s (%s)", style.bullet, style.subject(issue.Subject), reference))
+	}
+
+	return lines
+}
+
+func releaseTitle(release *release.Release) string {
+	if release.Unreleased {
+		return release.VersionName
+	}
+
+	return fmt.Sprintf("%s - %s", release.VersionName, formatReleaseDate(release.Date))
+}
+
+func singleLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func marshalMessage(message interface{}) string {
+	var output bytes.Buffer
+
+	encoder := json.NewEncoder(&output)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(message); err != nil {
+		return ""
+	}
+
+	return strings.TrimSuffix(output.String(), "\n")
+}
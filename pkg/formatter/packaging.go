@@ -0,0 +1,105 @@
+package formatter
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+var versionPrefixRegex = regexp.MustCompile(`^[vV]([0-9])`)
+
+type debianFormatter struct{}
+
+type rpmFormatter struct{}
+
+func NewDebianFormatter() Formatter {
+	return &debianFormatter{}
+}
+
+func NewRPMFormatter() Formatter {
+	return &rpmFormatter{}
+}
+
+func (d debianFormatter) Equal(other Formatter) bool {
+	_, hasGoodType := other.(*debianFormatter)
+
+	return hasGoodType
+}
+
+func (d debianFormatter) Format(release *release.Release) string {
+	distribution := release.Package.Distribution
+	if release.Unreleased {
+		distribution = "UNRELEASED"
+	}
+
+	var output bytes.Buffer
+
+	output.WriteString(fmt.Sprintf("%s (%s) %s; urgency=%s\n\n", release.Package.Name, packageVersion(release.VersionName), distribution, release.Package.Urgency))
+	for _, entry := range packageEntries(release) {
+		output.WriteString(fmt.Sprintf("  * %s\n", indentEntry(entry, "    ")))
+	}
+	output.WriteString(fmt.Sprintf("\n -- %s  %s\n", formatMaintainer(release.Package), release.Date.Format("Mon, 02 Jan 2006 15:04:05 -0700")))
+
+	return output.String()
+}
+
+func (r rpmFormatter) Equal(other Formatter) bool {
+	_, hasGoodType := other.(*rpmFormatter)
+
+	return hasGoodType
+}
+
+func (r rpmFormatter) Format(release *release.Release) string {
+	var output bytes.Buffer
+
+	output.WriteString(fmt.Sprintf("* %s %s - %s\n", release.Date.Format("Mon Jan 02 2006"), formatMaintainer(release.Package), packageVersion(release.VersionName)))
+	for _, entry := range packageEntries(release) {
+		output.WriteString(fmt.Sprintf("- %s\n", indentEntry(entry, "  ")))
+	}
+
+	return output.String()
+}
+
+func packageEntries(release *release.Release) []string {
+	var entries []string
+
+	for _, issue := range release.Issues {
+		entries = append(entries, fmt.Sprintf("%s (%s)", issue.Subject, issueLabel(issue.ID)))
+	}
+
+	for _, commit := range release.DirectCommits {
+		entries = append(entries, fmt.Sprintf("%s (%s)", commit.Commit.Message, commit.Commit.ShortID()))
+	}
+
+	for _, issue := range release.Reverted {
+		entries = append(entries, fmt.Sprintf("Revert: %s (%s)", issue.Subject, issueLabel(issue.ID)))
+	}
+
+	if len(entries) == 0 {
+		entries = append(entries, "No changes")
+	}
+
+	return entries
+}
+
+func indentEntry(entry string, indentation string) string {
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(entry), "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, strings.TrimSpace(line))
+		}
+	}
+
+	return strings.Join(lines, "\n"+indentation)
+}
+
+func packageVersion(versionName string) string {
+	return versionPrefixRegex.ReplaceAllString(versionName, "$1")
+}
+
+func formatMaintainer(releasePackage release.Package) string {
+	return fmt.Sprintf("%s <%s>", releasePackage.Maintainer.Fullname, releasePackage.Maintainer.Email)
+}
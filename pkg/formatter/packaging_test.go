@@ -0,0 +1,104 @@
+package formatter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kdisneur/changelog/pkg/bugtracker"
+	"github.com/kdisneur/changelog/pkg/formatter"
+	"github.com/kdisneur/changelog/pkg/git"
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+func TestPackagingFormatters(t *testing.T) {
+	releasePackage := release.Package{
+		Name:         "changelog",
+		Distribution: "stable",
+		Urgency:      "low",
+		Maintainer:   git.Person{Fullname: "Jane Doe", Email: "jane@example.com"},
+	}
+
+	fullRelease := &release.Release{
+		VersionName: "v1.0.0",
+		Date:        time.Date(2018, time.November, 19, 5, 12, 42, 0, time.UTC),
+		Package:     releasePackage,
+		Issues: []*bugtracker.Issue{
+			{ID: "42", Subject: "A nice feature:\n\n- with details", Link: "https://github.com/kdisneur/changelog/pull/42"},
+		},
+		DirectCommits: []*release.Commit{
+			{Commit: &git.Commit{ID: "a1b2c3d4e5f60718293a4b5c6d7e8f9012345678", Message: "Fix production crash"}},
+		},
+		Reverted: []*bugtracker.Issue{
+			{ID: "7", Subject: "A broken feature"},
+		},
+	}
+
+	unreleased := &release.Release{
+		VersionName: "Unreleased",
+		Date:        time.Date(2018, time.November, 19, 5, 12, 42, 0, time.UTC),
+		Package:     releasePackage,
+		Unreleased:  true,
+	}
+
+	testCases := []struct {
+		Name      string
+		Formatter formatter.Formatter
+		Release   *release.Release
+		Expected  string
+	}{
+		{
+			"When it is a Debian changelog",
+			formatter.NewDebianFormatter(),
+			fullRelease,
+			`changelog (1.0.0) stable; urgency=low
+
+  * A nice feature:
+    - with details (#42)
+  * Fix production crash (a1b2c3d)
+  * Revert: A broken feature (#7)
+
+ -- Jane Doe <jane@example.com>  Mon, 19 Nov 2018 05:12:42 +0000
+`,
+		},
+		{
+			"When it is an unreleased Debian changelog",
+			formatter.NewDebianFormatter(),
+			unreleased,
+			`changelog (Unreleased) UNRELEASED; urgency=low
+
+  * No changes
+
+ -- Jane Doe <jane@example.com>  Mon, 19 Nov 2018 05:12:42 +0000
+`,
+		},
+		{
+			"When it is an RPM changelog",
+			formatter.NewRPMFormatter(),
+			fullRelease,
+			`* Mon Nov 19 2018 Jane Doe <jane@example.com> - 1.0.0
+- A nice feature:
+  - with details (#42)
+- Fix production crash (a1b2c3d)
+- Revert: A broken feature (#7)
+`,
+		},
+		{
+			"When it is an RPM changelog without changes",
+			formatter.NewRPMFormatter(),
+			unreleased,
+			`* Mon Nov 19 2018 Jane Doe <jane@example.com> - Unreleased
+- No changes
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			actual := testCase.Formatter.Format(testCase.Release)
+
+			if actual != testCase.Expected {
+				t.Fatalf("Wrong output. Expected:\n%s\nReceived:\n%s", testCase.Expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+const (
+	slackMaxBlocks      = 50
+	slackMaxHeaderText  = 150
+	slackMaxSectionText = 3000
+)
+
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+var slackStyle = messageStyle{
+	bullet:  "•",
+	link:    slackLink,
+	subject: slackSubject,
+}
+
+type slackFormatter struct{}
+
+type slackMessage struct {
+	Text   string       `json:"text"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+type slackBlock struct {
+	Type     string      `json:"type"`
+	Text     *slackText  `json:"text,omitempty"`
+	Elements []slackText `json:"elements,omitempty"`
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+func NewSlackFormatter() Formatter {
+	return &slackFormatter{}
+}
+
+func (s slackFormatter) Equal(other Formatter) bool {
+	_, hasGoodType := other.(*slackFormatter)
+
+	return hasGoodType
+}
+
+func (s slackFormatter) Format(release *release.Release) string {
+	title := releaseTitle(release)
+	message := slackMessage{
+		Text:   slackEscaper.Replace(title),
+		Blocks: []slackBlock{{Type: "header", Text: &slackText{Type: "plain_text", Text: truncate(title, slackMaxHeaderText)}}},
+	}
+
+	var sections []slackBlock
+	for _, category := range messageCategories(release, slackStyle) {
+		sections = append(sections, slackSections(category.title, category.lines)...)
+	}
+
+	if len(sections) == 0 {
+		sections = append(sections, slackSection("No changes"))
+	}
+
+	var footer []slackBlock
+	if release.CompareLink != "" {
+		footer = append(footer, slackBlock{Type: "context", Elements: []slackText{{Type: "mrkdwn", Text: fmt.Sprintf("<%s|Compare the changes>", release.CompareLink)}}})
+	}
+
+	available := slackMaxBlocks - len(message.Blocks) - len(footer)
+	if len(sections) > available {
+		omitted := len(sections) - available + 1
+		sections = append(sections[:available-1], slackBlock{Type: "context", Elements: []slackText{{Type: "mrkdwn", Text: fmt.Sprintf("…and %d more block(s) not shown", omitted)}}})
+	}
+
+	message.Blocks = append(append(message.Blocks, sections...), footer...)
+
+	return marshalMessage(message)
+}
+
+func slackSections(title string, lines []string) []slackBlock {
+	var sections []slackBlock
+
+	text := fmt.Sprintf("*%s*", title)
+	for _, line := range lines {
+		line = truncate(line, slackMaxSectionText)
+
+		if len([]rune(text))+len([]rune(line))+1 > slackMaxSectionText {
+			sections = append(sections, slackSection(text))
+			text = line
+			continue
+		}
+
+		text = fmt.Sprintf("%s\n%s", text, line)
+	}
+
+	return append(sections, slackSection(text))
+}
+
+func slackSection(text string) slackBlock {
+	return slackBlock{Type: "section", Text: &slackText{Type: "mrkdwn", Text: text}}
+}
+
+func slackSubject(subject string) string {
+	return slackEscaper.Replace(singleLine(subject))
+}
+
+func slackLink(label string, link string) string {
+	if link == "" {
+		return slackEscaper.Replace(label)
+	}
+
+	return fmt.Sprintf("<%s|%s>", link, slackEscaper.Replace(label))
+}
+
+func truncate(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+
+	return string(runes[:limit-1]) + "…"
+}
@@ -0,0 +1,172 @@
+package formatter_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kdisneur/changelog/pkg/bugtracker"
+	"github.com/kdisneur/changelog/pkg/formatter"
+	"github.com/kdisneur/changelog/pkg/git"
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+func TestSlackFormatter(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Release  *release.Release
+		Expected string
+	}{
+		{
+			"When it contains several sections",
+			&release.Release{
+				VersionName: "v1.0.0",
+				Date:        time.Date(2018, time.November, 19, 5, 12, 42, 0, time.UTC),
+				CompareLink: "https://github.com/kdisneur/changelog/compare/v0.9.0...v1.0.0",
+				Issues: []*bugtracker.Issue{
+					{ID: "42", Subject: "Render <script> & friends", Link: "https://github.com/kdisneur/changelog/pull/42"},
+					{ID: "1337", Subject: "Another nice\nfeature", Link: "https://github.com/kdisneur/changelog/pull/1337", LinkedIssues: []bugtracker.LinkedIssue{{ID: "10", Link: "https://github.com/kdisneur/changelog/issues/10"}}},
+				},
+				DirectCommits: []*release.Commit{
+					{Commit: &git.Commit{ID: "a1b2c3d4e5f60718293a4b5c6d7e8f9012345678", Message: "Fix production crash"}, Link: "https://github.com/kdisneur/changelog/commit/a1b2c3d4e5f60718293a4b5c6d7e8f9012345678"},
+				},
+				Contributors: []*release.Contributor{
+					{Login: "janedoe", Link: "https://github.com/janedoe", FirstTime: true},
+					{Name: "John Doe"},
+				},
+			},
+			`{
+  "text": "v1.0.0 - 2018-11-19",
+  "blocks": [
+    {
+      "type": "header",
+      "text": {
+        "type": "plain_text",
+        "text": "v1.0.0 - 2018-11-19"
+      }
+    },
+    {
+      "type": "section",
+      "text": {
+        "type": "mrkdwn",
+        "text": "*Changes*\n• Render &lt;script&gt; &amp; friends (<https://github.com/kdisneur/changelog/pull/42|#42>)\n• Another nice feature (<https://github.com/kdisneur/changelog/pull/1337|#1337>, closes <https://github.com/kdisneur/changelog/issues/10|#10>)"
+      }
+    },
+    {
+      "type": "section",
+      "text": {
+        "type": "mrkdwn",
+        "text": "*Other changes*\n• Fix production crash (<https://github.com/kdisneur/changelog/commit/a1b2c3d4e5f60718293a4b5c6d7e8f9012345678|a1b2c3d>)"
+      }
+    },
+    {
+      "type": "section",
+      "text": {
+        "type": "mrkdwn",
+        "text": "*Contributors*\n• <https://github.com/janedoe|@janedoe> (first contribution)\n• John Doe"
+      }
+    },
+    {
+      "type": "context",
+      "elements": [
+        {
+          "type": "mrkdwn",
+          "text": "<https://github.com/kdisneur/changelog/compare/v0.9.0...v1.0.0|Compare the changes>"
+        }
+      ]
+    }
+  ]
+}`,
+		},
+		{
+			"When it is the unreleased section without changes",
+			&release.Release{
+				VersionName: "Unreleased",
+				Date:        time.Date(2018, time.November, 19, 5, 12, 42, 0, time.UTC),
+				Unreleased:  true,
+			},
+			`{
+  "text": "Unreleased",
+  "blocks": [
+    {
+      "type": "header",
+      "text": {
+        "type": "plain_text",
+        "text": "Unreleased"
+      }
+    },
+    {
+      "type": "section",
+      "text": {
+        "type": "mrkdwn",
+        "text": "No changes"
+      }
+    }
+  ]
+}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			actual := formatter.NewSlackFormatter().Format(testCase.Release)
+
+			if actual != testCase.Expected {
+				t.Fatalf("Wrong output. Expected:\n%s\nReceived:\n%s", testCase.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestSlackFormatterLimits(t *testing.T) {
+	type slackPayload struct {
+		Blocks []struct {
+			Type string `json:"type"`
+			Text struct {
+				Text string `json:"text"`
+			} `json:"text"`
+			Elements []struct {
+				Text string `json:"text"`
+			} `json:"elements"`
+		} `json:"blocks"`
+	}
+
+	newRelease := &release.Release{
+		VersionName: strings.Repeat("v", 200),
+		Date:        time.Date(2018, time.November, 19, 5, 12, 42, 0, time.UTC),
+	}
+
+	for index := 0; index < 1000; index++ {
+		newRelease.Issues = append(newRelease.Issues, &bugtracker.Issue{
+			ID:      fmt.Sprintf("%d", index),
+			Subject: strings.Repeat("a", 200),
+			Link:    fmt.Sprintf("https://github.com/kdisneur/changelog/pull/%d", index),
+		})
+	}
+
+	var payload slackPayload
+	if err := json.Unmarshal([]byte(formatter.NewSlackFormatter().Format(newRelease)), &payload); err != nil {
+		t.Fatalf("Expected a JSON payload. Received: %s", err.Error())
+	}
+
+	if len(payload.Blocks) != 50 {
+		t.Fatalf("Wrong number of blocks. Expected: 50. Received: %d", len(payload.Blocks))
+	}
+
+	if header := []rune(payload.Blocks[0].Text.Text); len(header) != 150 || header[149] != '…' {
+		t.Errorf("Expected the header to be truncated to 150 characters. Received: %s", string(header))
+	}
+
+	for _, block := range payload.Blocks[1:49] {
+		if length := len([]rune(block.Text.Text)); block.Type != "section" || length > 3000 {
+			t.Errorf("Expected sections of at most 3000 characters. Received a %s of %d characters", block.Type, length)
+		}
+	}
+
+	last := payload.Blocks[49]
+	if last.Type != "context" || len(last.Elements) != 1 || !strings.HasPrefix(last.Elements[0].Text, "…and ") {
+		t.Errorf("Expected the last block to tell the blocks were omitted. Received: %+v", last)
+	}
+}
@@ -0,0 +1,99 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+var teamsStyle = messageStyle{
+	bullet:  "-",
+	link:    teamsLink,
+	subject: singleLine,
+}
+
+type teamsFormatter struct{}
+
+type teamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []teamsAttachment `json:"attachments"`
+}
+
+type teamsAttachment struct {
+	ContentType string    `json:"contentType"`
+	Content     teamsCard `json:"content"`
+}
+
+type teamsCard struct {
+	Schema  string        `json:"$schema"`
+	Type    string        `json:"type"`
+	Version string        `json:"version"`
+	Body    []teamsBlock  `json:"body"`
+	Actions []teamsAction `json:"actions,omitempty"`
+}
+
+type teamsBlock struct {
+	Type    string `json:"type"`
+	Text    string `json:"text"`
+	Size    string `json:"size,omitempty"`
+	Weight  string `json:"weight,omitempty"`
+	Spacing string `json:"spacing,omitempty"`
+	Wrap    bool   `json:"wrap"`
+}
+
+type teamsAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+func NewTeamsFormatter() Formatter {
+	return &teamsFormatter{}
+}
+
+func (t teamsFormatter) Equal(other Formatter) bool {
+	_, hasGoodType := other.(*teamsFormatter)
+
+	return hasGoodType
+}
+
+func (t teamsFormatter) Format(release *release.Release) string {
+	card := teamsCard{
+		Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+		Type:    "AdaptiveCard",
+		Version: "1.4",
+		Body:    []teamsBlock{{Type: "TextBlock", Text: releaseTitle(release), Size: "Large", Weight: "Bolder", Wrap: true}},
+	}
+
+	categories := messageCategories(release, teamsStyle)
+	if len(categories) == 0 {
+		card.Body = append(card.Body, teamsBlock{Type: "TextBlock", Text: "No changes", Wrap: true})
+	}
+
+	for _, category := range categories {
+		card.Body = append(card.Body,
+			teamsBlock{Type: "TextBlock", Text: category.title, Weight: "Bolder", Spacing: "Medium", Wrap: true},
+			teamsBlock{Type: "TextBlock", Text: strings.Join(category.lines, "\n"), Wrap: true},
+		)
+	}
+
+	if release.CompareLink != "" {
+		card.Actions = append(card.Actions, teamsAction{Type: "Action.OpenUrl", Title: "Compare the changes", URL: release.CompareLink})
+	}
+
+	message := teamsMessage{
+		Type:        "message",
+		Attachments: []teamsAttachment{{ContentType: "application/vnd.microsoft.card.adaptive", Content: card}},
+	}
+
+	return marshalMessage(message)
+}
+
+func teamsLink(label string, link string) string {
+	if link == "" {
+		return label
+	}
+
+	return fmt.Sprintf("[%s](%s)", label, link)
+}
@@ -0,0 +1,132 @@
+package formatter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kdisneur/changelog/pkg/bugtracker"
+	"github.com/kdisneur/changelog/pkg/formatter"
+	"github.com/kdisneur/changelog/pkg/release"
+)
+
+func TestTeamsFormatter(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Release  *release.Release
+		Expected string
+	}{
+		{
+			"When it contains several sections",
+			&release.Release{
+				VersionName: "v1.0.0",
+				Date:        time.Date(2018, time.November, 19, 5, 12, 42, 0, time.UTC),
+				CompareLink: "https://github.com/kdisneur/changelog/compare/v0.9.0...v1.0.0",
+				Issues: []*bugtracker.Issue{
+					{ID: "42", Subject: "A nice feature", Link: "https://github.com/kdisneur/changelog/pull/42"},
+					{ID: "1337", Subject: "Another nice feature", Link: "https://github.com/kdisneur/changelog/pull/1337"},
+				},
+				Reverted: []*bugtracker.Issue{
+					{ID: "7", Subject: "A broken feature"},
+				},
+			},
+			`{
+  "type": "message",
+  "attachments": [
+    {
+      "contentType": "application/vnd.microsoft.card.adaptive",
+      "content": {
+        "$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+        "type": "AdaptiveCard",
+        "version": "1.4",
+        "body": [
+          {
+            "type": "TextBlock",
+            "text": "v1.0.0 - 2018-11-19",
+            "size": "Large",
+            "weight": "Bolder",
+            "wrap": true
+          },
+          {
+            "type": "TextBlock",
+            "text": "Changes",
+            "weight": "Bolder",
+            "spacing": "Medium",
+            "wrap": true
+          },
+          {
+            "type": "TextBlock",
+            "text": "- A nice feature ([#42](https://github.com/kdisneur/changelog/pull/42))\n- Another nice feature ([#1337](https://github.com/kdisneur/changelog/pull/1337))",
+            "wrap": true
+          },
+          {
+            "type": "TextBlock",
+            "text": "Reverted",
+            "weight": "Bolder",
+            "spacing": "Medium",
+            "wrap": true
+          },
+          {
+            "type": "TextBlock",
+            "text": "- A broken feature (#7)",
+            "wrap": true
+          }
+        ],
+        "actions": [
+          {
+            "type": "Action.OpenUrl",
+            "title": "Compare the changes",
+            "url": "https://github.com/kdisneur/changelog/compare/v0.9.0...v1.0.0"
+          }
+        ]
+      }
+    }
+  ]
+}`,
+		},
+		{
+			"When it is the unreleased section without changes",
+			&release.Release{
+				VersionName: "Unreleased",
+				Date:        time.Date(2018, time.November, 19, 5, 12, 42, 0, time.UTC),
+				Unreleased:  true,
+			},
+			`{
+  "type": "message",
+  "attachments": [
+    {
+      "contentType": "application/vnd.microsoft.card.adaptive",
+      "content": {
+        "$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+        "type": "AdaptiveCard",
+        "version": "1.4",
+        "body": [
+          {
+            "type": "TextBlock",
+            "text": "Unreleased",
+            "size": "Large",
+            "weight": "Bolder",
+            "wrap": true
+          },
+          {
+            "type": "TextBlock",
+            "text": "No changes",
+            "wrap": true
+          }
+        ]
+      }
+    }
+  ]
+}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			actual := formatter.NewTeamsFormatter().Format(testCase.Release)
+
+			if actual != testCase.Expected {
+				t.Fatalf("Wrong output. Expected:\n%s\nReceived:\n%s", testCase.Expected, actual)
+			}
+		})
+	}
+}
@@ -1,11 +1,10 @@
 package formatter
 
 import (
-	"github.com/kdisneur/changelog/pkg/bugtracker"
-	"time"
+	"github.com/kdisneur/changelog/pkg/release"
 )
 
 type Formatter interface {
 	Equal(other Formatter) bool
-	FormatIssues(versionName string, date time.Time, issues []*bugtracker.Issue) string
+	Format(release *release.Release) string
 }
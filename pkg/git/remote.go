@@ -0,0 +1,59 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (r *Remote) Equal(other *Remote) bool {
+	if r == nil || other == nil {
+		return r == other
+	}
+
+	return r.Type == other.Type && r.Host == other.Host && r.RepositoryName == other.RepositoryName
+}
+
+func (r *Remote) WebURL() string {
+	return fmt.Sprintf("https://%s/%s", r.Host, r.RepositoryName)
+}
+
+func (r *Remote) CommitURL(id string) string {
+	switch {
+	case r.isGitLab():
+		return fmt.Sprintf("%s/-/commit/%s", r.WebURL(), id)
+	case r.isBitbucket():
+		return fmt.Sprintf("%s/commits/%s", r.WebURL(), id)
+	default:
+		return fmt.Sprintf("%s/commit/%s", r.WebURL(), id)
+	}
+}
+
+func (r *Remote) CompareURL(from string, to string) string {
+	switch {
+	case r.isGitLab():
+		return fmt.Sprintf("%s/-/compare/%s...%s", r.WebURL(), from, to)
+	case r.isBitbucket():
+		return fmt.Sprintf("%s/branches/compare/%s%%0D%s", r.WebURL(), to, from)
+	default:
+		return fmt.Sprintf("%s/compare/%s...%s", r.WebURL(), from, to)
+	}
+}
+
+func (r *Remote) ReleaseURL(tag string) string {
+	switch {
+	case r.isGitLab():
+		return fmt.Sprintf("%s/-/releases/%s", r.WebURL(), tag)
+	case r.isBitbucket():
+		return fmt.Sprintf("%s/src/%s", r.WebURL(), tag)
+	default:
+		return fmt.Sprintf("%s/releases/tag/%s", r.WebURL(), tag)
+	}
+}
+
+func (r *Remote) isGitLab() bool {
+	return strings.Contains(r.Host, "gitlab")
+}
+
+func (r *Remote) isBitbucket() bool {
+	return strings.Contains(r.Host, "bitbucket")
+}
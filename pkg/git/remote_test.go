@@ -0,0 +1,109 @@
+package git_test
+
+import (
+	"testing"
+
+	"github.com/kdisneur/changelog/pkg/git"
+)
+
+func TestRemoteCommitURL(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Remote   git.Remote
+		Expected string
+	}{
+		{
+			"When remote is hosted on GitHub",
+			git.Remote{Type: git.GIT, Host: "github.com", RepositoryName: "kdisneur/changelog"},
+			"https://github.com/kdisneur/changelog/commit/4f28c412c51c44c94daa3fced544567c3f94dd7b",
+		},
+		{
+			"When remote is hosted on GitLab",
+			git.Remote{Type: git.HTTPS, Host: "gitlab.com", RepositoryName: "kdisneur/changelog"},
+			"https://gitlab.com/kdisneur/changelog/-/commit/4f28c412c51c44c94daa3fced544567c3f94dd7b",
+		},
+		{
+			"When remote is hosted on Bitbucket",
+			git.Remote{Type: git.HTTPS, Host: "bitbucket.org", RepositoryName: "kdisneur/changelog"},
+			"https://bitbucket.org/kdisneur/changelog/commits/4f28c412c51c44c94daa3fced544567c3f94dd7b",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			actual := testCase.Remote.CommitURL("4f28c412c51c44c94daa3fced544567c3f94dd7b")
+
+			if actual != testCase.Expected {
+				t.Errorf("Wrong commit URL. Expected: %s\nReceived: %s", testCase.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestRemoteCompareURL(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Remote   git.Remote
+		Expected string
+	}{
+		{
+			"When remote is hosted on GitHub",
+			git.Remote{Type: git.GIT, Host: "github.com", RepositoryName: "kdisneur/changelog"},
+			"https://github.com/kdisneur/changelog/compare/v1.4.0...v1.5.0",
+		},
+		{
+			"When remote is hosted on GitLab",
+			git.Remote{Type: git.HTTPS, Host: "gitlab.com", RepositoryName: "kdisneur/changelog"},
+			"https://gitlab.com/kdisneur/changelog/-/compare/v1.4.0...v1.5.0",
+		},
+		{
+			"When remote is hosted on Bitbucket",
+			git.Remote{Type: git.HTTPS, Host: "bitbucket.org", RepositoryName: "kdisneur/changelog"},
+			"https://bitbucket.org/kdisneur/changelog/branches/compare/v1.5.0%0Dv1.4.0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			actual := testCase.Remote.CompareURL("v1.4.0", "v1.5.0")
+
+			if actual != testCase.Expected {
+				t.Errorf("Wrong compare URL. Expected: %s\nReceived: %s", testCase.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestRemoteReleaseURL(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Remote   git.Remote
+		Expected string
+	}{
+		{
+			"When remote is hosted on GitHub",
+			git.Remote{Type: git.GIT, Host: "github.com", RepositoryName: "kdisneur/changelog"},
+			"https://github.com/kdisneur/changelog/releases/tag/v1.5.0",
+		},
+		{
+			"When remote is hosted on GitLab",
+			git.Remote{Type: git.HTTPS, Host: "gitlab.com", RepositoryName: "kdisneur/changelog"},
+			"https://gitlab.com/kdisneur/changelog/-/releases/v1.5.0",
+		},
+		{
+			"When remote is hosted on Bitbucket",
+			git.Remote{Type: git.HTTPS, Host: "bitbucket.org", RepositoryName: "kdisneur/changelog"},
+			"https://bitbucket.org/kdisneur/changelog/src/v1.5.0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			actual := testCase.Remote.ReleaseURL("v1.5.0")
+
+			if actual != testCase.Expected {
+				t.Errorf("Wrong release URL. Expected: %s\nReceived: %s", testCase.Expected, actual)
+			}
+		})
+	}
+}
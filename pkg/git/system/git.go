@@ -4,14 +4,17 @@ import (
 	"bufio"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/kdisneur/changelog/pkg/git"
 	sysutils "github.com/kdisneur/changelog/pkg/git/system/utils"
 	"github.com/kdisneur/changelog/pkg/git/utils"
-	"github.com/kdisneur/changelog/pkg/time"
+	timeutils "github.com/kdisneur/changelog/pkg/time"
 	"github.com/pkg/errors"
 )
 
+const logFormat = "--format=%H;%an;%aE;%at;%cn;%ce;%ct;%P;%s%x1f%b%x1e"
+
 type Repository struct {
 	RepositoryPath git.Path
 }
@@ -34,29 +37,27 @@ func (r Repository) Equal(other git.Git) bool {
 }
 
 func (r Repository) FindRemote() (*git.Remote, error) {
-	commandResult, err := sysutils.ExecCommand(r.RepositoryPath.String(), "remote", "--verbose")
+	remoteURLs, err := sysutils.RemoteURLs(r.RepositoryPath.String())
 
 	if err != nil {
 		return nil, errors.Wrapf(err, "Can't find git remotes")
 	}
 
-	rawRemotes := strings.Split(commandResult, "\n")
-
-	var remoteURLs []string
-	for _, rawRemote := range rawRemotes {
-		remoteData := strings.Fields(rawRemote)
-		if len(remoteData) > 1 {
-			remoteURLs = append(remoteURLs, remoteData[1])
-		}
-	}
-
 	return utils.FindRemoteFromURLs(remoteURLs)
 }
 
 func (r Repository) Log(from git.Reference, to git.Reference) ([]*git.Commit, error) {
 	span := fmt.Sprintf("%s..%s", string(from), string(to))
+	if from == "" {
+		span = string(to)
+	}
 
-	rawCommits, err := sysutils.ExecCommand(r.RepositoryPath.String(), "log", "--pretty=oneline", "--format=%H;%an;%aE;%at;%cn;%ce;%ct;%p;%s", span)
+	err := checkReferences(from, to)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't generate git logs for '%s' in %s", span, r.RepositoryPath)
+	}
+
+	rawCommits, err := sysutils.ExecCommand(r.RepositoryPath.String(), "log", logFormat, span)
 
 	if err != nil {
 		return nil, errors.Wrapf(err, "Can't generate git logs for '%s' in %s", span, r.RepositoryPath)
@@ -65,12 +66,154 @@ func (r Repository) Log(from git.Reference, to git.Reference) ([]*git.Commit, er
 	return parseRawCommits(rawCommits)
 }
 
-func parseRawCommits(rawCommit string) ([]*git.Commit, error) {
-	scanner := bufio.NewScanner(strings.NewReader(rawCommit))
+func (r Repository) FindCommit(id string) (*git.Commit, error) {
+	err := checkReferences(git.Reference(id))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't find git commit '%s' in %s", id, r.RepositoryPath)
+	}
 
-	var commits []*git.Commit
+	rawCommits, err := sysutils.ExecCommand(r.RepositoryPath.String(), "log", "-1", logFormat, id)
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't find git commit '%s' in %s", id, r.RepositoryPath)
+	}
+
+	commits, err := parseRawCommits(rawCommits)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(commits) == 0 {
+		return nil, errors.New(fmt.Sprintf("Can't find git commit '%s' in %s", id, r.RepositoryPath))
+	}
+
+	return commits[0], nil
+}
+
+func (r Repository) RecentCommits(count int) ([]*git.Commit, error) {
+	rawCommits, err := sysutils.ExecCommand(r.RepositoryPath.String(), "log", fmt.Sprintf("--max-count=%d", count), logFormat)
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't list recent git commits in %s", r.RepositoryPath)
+	}
+
+	return parseRawCommits(rawCommits)
+}
+
+func (r Repository) TagDate(tag git.Reference) (time.Time, error) {
+	rawDate, err := sysutils.ExecCommand(r.RepositoryPath.String(), "for-each-ref", "--format=%(creatordate:unix)", fmt.Sprintf("refs/tags/%s", tag))
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "Can't find git tag '%s' in %s", tag, r.RepositoryPath)
+	}
+
+	rawDate = strings.TrimSpace(rawDate)
+	if rawDate == "" {
+		return time.Time{}, errors.New(fmt.Sprintf("Can't find git tag '%s' in %s", tag, r.RepositoryPath))
+	}
+
+	return timeutils.FromStringTimestamp(rawDate)
+}
+
+func (r Repository) LatestTag(reference git.Reference) (git.Reference, error) {
+	err := checkReferences(reference)
+	if err != nil {
+		return "", errors.Wrapf(err, "Can't find a git tag reachable from '%s' in %s", reference, r.RepositoryPath)
+	}
+
+	tag, err := sysutils.ExecCommand(r.RepositoryPath.String(), "describe", "--tags", "--abbrev=0", string(reference))
+	if err != nil {
+		return "", errors.Wrapf(err, "Can't find a git tag reachable from '%s' in %s", reference, r.RepositoryPath)
+	}
+
+	return git.NewReference(strings.TrimSpace(tag)), nil
+}
+
+func (r Repository) Tags(reference git.Reference) ([]git.Reference, error) {
+	rawTags, err := sysutils.ExecCommand(r.RepositoryPath.String(), "tag", fmt.Sprintf("--merged=%s", reference), "--sort=creatordate")
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't list git tags reachable from '%s' in %s", reference, r.RepositoryPath)
+	}
+
+	var tags []git.Reference
+	for _, tag := range strings.Split(rawTags, "\n") {
+		if strings.TrimSpace(tag) != "" {
+			tags = append(tags, git.NewReference(strings.TrimSpace(tag)))
+		}
+	}
+
+	return tags, nil
+}
+
+func (r Repository) DefaultBranch() (string, error) {
+	remoteHead, err := sysutils.ExecCommand(r.RepositoryPath.String(), "symbolic-ref", "--short", "refs/remotes/origin/HEAD")
+	if err == nil {
+		return strings.TrimPrefix(strings.TrimSpace(remoteHead), "origin/"), nil
+	}
+
+	currentBranch, err := sysutils.ExecCommand(r.RepositoryPath.String(), "symbolic-ref", "--short", "HEAD")
+	if err != nil {
+		return "", errors.Wrapf(err, "Can't find the default git branch in %s", r.RepositoryPath)
+	}
+
+	return strings.TrimSpace(currentBranch), nil
+}
+
+func (r Repository) Authors(reference git.Reference) ([]git.Person, error) {
+	err := checkReferences(reference)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't list git authors for '%s' in %s", reference, r.RepositoryPath)
+	}
+
+	rawAuthors, err := sysutils.ExecCommand(r.RepositoryPath.String(), "log", "--format=%aE;%an", string(reference))
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't list git authors for '%s' in %s", reference, r.RepositoryPath)
+	}
+
+	return parseRawAuthors(rawAuthors)
+}
+
+func checkReferences(references ...git.Reference) error {
+	for _, reference := range references {
+		if strings.HasPrefix(string(reference), "-") {
+			return errors.New(fmt.Sprintf("Can't use reference '%s' starting with '-'", reference))
+		}
+	}
+
+	return nil
+}
+
+func parseRawAuthors(rawAuthors string) ([]git.Person, error) {
+	scanner := bufio.NewScanner(strings.NewReader(rawAuthors))
+
+	var authors []git.Person
+	seen := make(map[string]bool)
 	for scanner.Scan() {
-		commit, err := parseRawCommit(scanner.Text())
+		authorData := strings.SplitN(scanner.Text(), ";", 2)
+		if len(authorData) != 2 {
+			return nil, errors.New(fmt.Sprintf("Can't parse author '%s'", scanner.Text()))
+		}
+
+		if seen[scanner.Text()] {
+			continue
+		}
+		seen[scanner.Text()] = true
+
+		authors = append(authors, git.NewPerson(authorData[1], authorData[0]))
+	}
+
+	return authors, nil
+}
+
+func parseRawCommits(rawCommits string) ([]*git.Commit, error) {
+	var commits []*git.Commit
+	for _, rawCommit := range strings.Split(rawCommits, "\x1e") {
+		rawCommit = strings.TrimLeft(rawCommit, "\n")
+		if rawCommit == "" {
+			continue
+		}
+
+		commit, err := parseRawCommit(rawCommit)
 		if err != nil {
 			return nil, err
 		}
@@ -82,7 +225,12 @@ func parseRawCommits(rawCommit string) ([]*git.Commit, error) {
 }
 
 func parseRawCommit(rawCommit string) (*git.Commit, error) {
-	commitData := strings.Split(rawCommit, ";")
+	headerAndBody := strings.SplitN(rawCommit, "\x1f", 2)
+	if len(headerAndBody) != 2 {
+		return nil, errors.New(fmt.Sprintf("Can't parse '%s'", rawCommit))
+	}
+
+	commitData := strings.SplitN(headerAndBody[0], ";", 9)
 	if len(commitData) != 9 {
 		return nil, errors.New(fmt.Sprintf("Can't parse '%s'", rawCommit))
 	}
@@ -99,12 +247,12 @@ func parseRawCommit(rawCommit string) (*git.Commit, error) {
 
 	author := git.NewPerson(authorName, authorEmail)
 	committer := git.NewPerson(committerName, committerEmail)
-	authoredAt, err := time.FromStringTimestamp(authorTimestamp)
+	authoredAt, err := timeutils.FromStringTimestamp(authorTimestamp)
 	if err != nil {
 		return nil, errors.Wrap(err, "Can't parse author timestamp")
 	}
 
-	committedAt, err := time.FromStringTimestamp(commitTimestamp)
+	committedAt, err := timeutils.FromStringTimestamp(commitTimestamp)
 	if err != nil {
 		return nil, errors.Wrap(err, "Can't parse committer timestamp")
 	}
@@ -118,6 +266,8 @@ func parseRawCommit(rawCommit string) (*git.Commit, error) {
 		Committer:   committer,
 		CommittedAt: committedAt,
 		IsMerge:     isMerge,
+		Parents:     strings.Fields(parentHashes),
 		Message:     message,
+		Body:        strings.TrimSpace(headerAndBody[1]),
 	}, nil
 }
@@ -168,6 +168,45 @@ func TestLog(t *testing.T) {
 			"Can't generate git logs for 'v1.0.0..inexistent'",
 			[]*git.Commit{},
 		},
+		{
+			"When `from` reference is a git option",
+			"squash",
+			git.Reference("--output=changelog-injected"),
+			git.Reference("master"),
+			false,
+			"Can't generate git logs for '--output=changelog-injected..master'",
+			[]*git.Commit{},
+		},
+		{
+			"When `from` reference is empty",
+			"squash",
+			git.Reference(""),
+			git.Reference("v1.0.0"),
+			true,
+			"",
+			[]*git.Commit{
+				{
+					ID:          "555475c1e0c506eaf23d0db155f6592f7383c495",
+					Author:      git.Person{Fullname: "John Doe", Email: "johndoe@gmail.com"},
+					AuthoredAt:  time.Date(2018, time.November, 17, 6, 29, 46, 0, centralEuropeTime),
+					Committer:   git.Person{Fullname: "Kevin Disneur", Email: "kevin@disneur.me"},
+					CommittedAt: time.Date(2018, time.November, 17, 6, 30, 38, 0, centralEuropeTime),
+					IsMerge:     false,
+					Message:     "Adding feature 2 (#42)",
+					Body:        "A long text explaining what we did in the feature 2 because it's\nimportant to have a good Git history.",
+				},
+				{
+					ID:          "6398b4e189b94ce300641431d3dfa00c373d1bb1",
+					Author:      git.Person{Fullname: "Kevin Disneur", Email: "kevin@disneur.me"},
+					AuthoredAt:  time.Date(2018, time.November, 17, 6, 27, 17, 0, centralEuropeTime),
+					Committer:   git.Person{Fullname: "Kevin Disneur", Email: "kevin@disneur.me"},
+					CommittedAt: time.Date(2018, time.November, 17, 6, 27, 49, 0, centralEuropeTime),
+					IsMerge:     false,
+					Message:     "Adding feature 1 (#21)",
+					Body:        "A long text explaining what we did in the feature 1 because it's\nimportant to have a good Git history.",
+				},
+			},
+		},
 		{
 			"When `from` and `to` exists",
 			"squash",
@@ -184,6 +223,7 @@ func TestLog(t *testing.T) {
 					CommittedAt: time.Date(2018, time.November, 17, 20, 35, 21, 0, centralEuropeTime),
 					IsMerge:     false,
 					Message:     "Adding feature 4 (#777)",
+					Body:        "A long text explaining what we did in the feature 4 because it's\nimportant to have a good Git history.",
 				},
 				{
 					ID:          "a2bc4fd34ba164ad0c1a264340ce37b0dbdaa6ef",
@@ -193,6 +233,7 @@ func TestLog(t *testing.T) {
 					CommittedAt: time.Date(2018, time.November, 17, 6, 35, 51, 0, centralEuropeTime),
 					IsMerge:     false,
 					Message:     "Adding feature 3 (#1337)",
+					Body:        "A long text explaining what we did in the feature 3 because it's\nimportant to have a good Git history.",
 				},
 			},
 		},
@@ -235,3 +276,317 @@ func TestLog(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthors(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		FixtureName     string
+		Reference       git.Reference
+		IsValid         bool
+		ErrorMessage    string
+		ExpectedAuthors []git.Person
+	}{
+		{
+			"When reference exists",
+			"squash",
+			git.Reference("v1.0.0"),
+			true,
+			"",
+			[]git.Person{
+				{Fullname: "John Doe", Email: "johndoe@gmail.com"},
+				{Fullname: "Kevin Disneur", Email: "kevin@disneur.me"},
+			},
+		},
+		{
+			"When reference doesn't exist",
+			"squash",
+			git.Reference("inexistent"),
+			false,
+			"Can't list git authors for 'inexistent'",
+			nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repository, cleanup, err := setupFixture(testCase.FixtureName)
+			defer cleanup()
+
+			authors, err := repository.Authors(testCase.Reference)
+
+			if err != nil && testCase.IsValid {
+				t.Fatalf("Expected no errors but go one: %s", err.Error())
+			}
+
+			if err == nil && !testCase.IsValid {
+				t.Fatalf("Expected errors but go none: %v", authors)
+			}
+
+			if !testCase.IsValid && !strings.Contains(err.Error(), testCase.ErrorMessage) {
+				t.Fatalf("Wrong error: Expected to contain '%s', got: '%s'", testCase.ErrorMessage, err.Error())
+			}
+
+			if testCase.IsValid {
+				if len(authors) != len(testCase.ExpectedAuthors) {
+					t.Fatalf("Expected to find %d authors, found %d.\nExpected: %+v\nReceived: %+v", len(testCase.ExpectedAuthors), len(authors), testCase.ExpectedAuthors, authors)
+				}
+
+				for index, expectedAuthor := range testCase.ExpectedAuthors {
+					if !expectedAuthor.Equal(authors[index]) {
+						t.Errorf("Wrong author.\nExpected: %+v\nReceived: %+v", expectedAuthor, authors[index])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestFindCommit(t *testing.T) {
+	secondsCETOfUTC := int((1 * time.Hour).Seconds())
+	centralEuropeTime := time.FixedZone("CET", secondsCETOfUTC)
+
+	testCases := []struct {
+		Name           string
+		FixtureName    string
+		ID             string
+		IsValid        bool
+		ErrorMessage   string
+		ExpectedCommit *git.Commit
+	}{
+		{
+			"When commit exists",
+			"squash",
+			"a2bc4fd",
+			true,
+			"",
+			&git.Commit{
+				ID:          "a2bc4fd34ba164ad0c1a264340ce37b0dbdaa6ef",
+				Author:      git.Person{Fullname: "Kevin Disneur", Email: "kevin@disneur.me"},
+				AuthoredAt:  time.Date(2018, time.November, 17, 6, 35, 51, 0, centralEuropeTime),
+				Committer:   git.Person{Fullname: "Kevin Disneur", Email: "kevin@disneur.me"},
+				CommittedAt: time.Date(2018, time.November, 17, 6, 35, 51, 0, centralEuropeTime),
+				IsMerge:     false,
+				Message:     "Adding feature 3 (#1337)",
+				Body:        "A long text explaining what we did in the feature 3 because it's\nimportant to have a good Git history.",
+			},
+		},
+		{
+			"When commit doesn't exist",
+			"squash",
+			"0000000",
+			false,
+			"Can't find git commit '0000000'",
+			nil,
+		},
+		{
+			"When commit is a git option",
+			"squash",
+			"--output=changelog-injected",
+			false,
+			"Can't find git commit '--output=changelog-injected'",
+			nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repository, cleanup, err := setupFixture(testCase.FixtureName)
+			defer cleanup()
+
+			commit, err := repository.FindCommit(testCase.ID)
+
+			if err != nil && testCase.IsValid {
+				t.Fatalf("Expected no errors but go one: %s", err.Error())
+			}
+
+			if err == nil && !testCase.IsValid {
+				t.Fatalf("Expected errors but go none: %v", commit)
+			}
+
+			if !testCase.IsValid && !strings.Contains(err.Error(), testCase.ErrorMessage) {
+				t.Fatalf("Wrong error: Expected to contain '%s', got: '%s'", testCase.ErrorMessage, err.Error())
+			}
+
+			if testCase.IsValid && !testCase.ExpectedCommit.Equal(commit) {
+				t.Errorf("Wrong Commit.\nExpected: %+v\nReceived: %+v", testCase.ExpectedCommit, commit)
+			}
+
+			if testCase.IsValid && strings.Join(commit.Parents, ",") != "555475c1e0c506eaf23d0db155f6592f7383c495" {
+				t.Errorf("Wrong parents. Received: %v", commit.Parents)
+			}
+		})
+	}
+}
+
+func TestDefaultBranch(t *testing.T) {
+	repository, cleanup, err := setupFixture("squash")
+	defer cleanup()
+
+	if err != nil {
+		t.Fatalf("Expected no errors but go one: %s", err.Error())
+	}
+
+	branch, err := repository.DefaultBranch()
+	if err != nil {
+		t.Fatalf("Expected no errors but go one: %s", err.Error())
+	}
+
+	if branch != "master" {
+		t.Errorf("Wrong default branch.\nExpected: master\nReceived: %s", branch)
+	}
+}
+
+func TestRecentCommits(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Count       int
+		ExpectedIDs []string
+	}{
+		{"When asking for less commits than available", 2, []string{"4f28c41", "a2bc4fd"}},
+		{"When asking for more commits than available", 10, []string{"4f28c41", "a2bc4fd", "555475c", "6398b4e"}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repository, cleanup, err := setupFixture("squash")
+			defer cleanup()
+
+			commits, err := repository.RecentCommits(testCase.Count)
+			if err != nil {
+				t.Fatalf("Expected no errors but go one: %s", err.Error())
+			}
+
+			var ids []string
+			for _, commit := range commits {
+				ids = append(ids, commit.ShortID())
+			}
+
+			if strings.Join(ids, ",") != strings.Join(testCase.ExpectedIDs, ",") {
+				t.Errorf("Wrong commits.\nExpected: %v\nReceived: %v", testCase.ExpectedIDs, ids)
+			}
+		})
+	}
+}
+
+func TestTagDate(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Tag          git.Reference
+		IsValid      bool
+		ErrorMessage string
+		Expected     time.Time
+	}{
+		{"When tag exists", "v1.0.0", true, "", time.Unix(1542432638, 0)},
+		{"When reference is a branch", "master", false, "Can't find git tag 'master'", time.Time{}},
+		{"When tag doesn't exist", "v9.9.9", false, "Can't find git tag 'v9.9.9'", time.Time{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repository, cleanup, err := setupFixture("squash")
+			defer cleanup()
+
+			date, err := repository.TagDate(testCase.Tag)
+
+			if err != nil && testCase.IsValid {
+				t.Fatalf("Expected no errors but go one: %s", err.Error())
+			}
+
+			if err == nil && !testCase.IsValid {
+				t.Fatalf("Expected errors but go none: %v", date)
+			}
+
+			if !testCase.IsValid && !strings.Contains(err.Error(), testCase.ErrorMessage) {
+				t.Fatalf("Wrong error: Expected to contain '%s', got: '%s'", testCase.ErrorMessage, err.Error())
+			}
+
+			if testCase.IsValid && !testCase.Expected.Equal(date) {
+				t.Errorf("Wrong date.\nExpected: %s\nReceived: %s", testCase.Expected, date)
+			}
+		})
+	}
+}
+
+func TestLatestTag(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Reference    git.Reference
+		IsValid      bool
+		ErrorMessage string
+		Expected     git.Reference
+	}{
+		{"When tag is behind the reference", "master", true, "", "v1.0.0"},
+		{"When reference is the tag", "v1.0.0", true, "", "v1.0.0"},
+		{"When no tag is reachable", "6398b4e", false, "Can't find a git tag reachable from '6398b4e'", ""},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repository, cleanup, err := setupFixture("squash")
+			defer cleanup()
+
+			tag, err := repository.LatestTag(testCase.Reference)
+
+			if err != nil && testCase.IsValid {
+				t.Fatalf("Expected no errors but go one: %s", err.Error())
+			}
+
+			if err == nil && !testCase.IsValid {
+				t.Fatalf("Expected errors but go none: %s", tag)
+			}
+
+			if !testCase.IsValid && !strings.Contains(err.Error(), testCase.ErrorMessage) {
+				t.Fatalf("Wrong error: Expected to contain '%s', got: '%s'", testCase.ErrorMessage, err.Error())
+			}
+
+			if testCase.IsValid && tag != testCase.Expected {
+				t.Errorf("Wrong tag.\nExpected: %s\nReceived: %s", testCase.Expected, tag)
+			}
+		})
+	}
+}
+
+func TestTags(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Reference    git.Reference
+		IsValid      bool
+		ErrorMessage string
+		Expected     []git.Reference
+	}{
+		{"When tags are reachable", "master", true, "", []git.Reference{"v1.0.0"}},
+		{"When no tag is reachable", "6398b4e", true, "", nil},
+		{"When reference doesn't exist", "inexistent", false, "Can't list git tags reachable from 'inexistent'", nil},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repository, cleanup, err := setupFixture("squash")
+			defer cleanup()
+
+			tags, err := repository.Tags(testCase.Reference)
+
+			if err != nil && testCase.IsValid {
+				t.Fatalf("Expected no errors but go one: %s", err.Error())
+			}
+
+			if err == nil && !testCase.IsValid {
+				t.Fatalf("Expected errors but go none: %v", tags)
+			}
+
+			if !testCase.IsValid && !strings.Contains(err.Error(), testCase.ErrorMessage) {
+				t.Fatalf("Wrong error: Expected to contain '%s', got: '%s'", testCase.ErrorMessage, err.Error())
+			}
+
+			if testCase.IsValid && len(tags) != len(testCase.Expected) {
+				t.Fatalf("Wrong tags.\nExpected: %v\nReceived: %v", testCase.Expected, tags)
+			}
+
+			for index, tag := range testCase.Expected {
+				if tags[index] != tag {
+					t.Errorf("Wrong tags.\nExpected: %v\nReceived: %v", testCase.Expected, tags)
+				}
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"os"
 	"path"
+	"strings"
 )
 
 func IsGitRepository(repositoryPath string) bool {
@@ -10,3 +11,29 @@ func IsGitRepository(repositoryPath string) bool {
 
 	return err == nil || !os.IsNotExist(err)
 }
+
+func TopLevelPath(repositoryPath string) (string, error) {
+	output, err := ExecCommand(repositoryPath, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(output), nil
+}
+
+func RemoteURLs(repositoryPath string) ([]string, error) {
+	output, err := ExecCommand(repositoryPath, "remote", "--verbose")
+	if err != nil {
+		return nil, err
+	}
+
+	var remoteURLs []string
+	for _, rawRemote := range strings.Split(output, "\n") {
+		remoteData := strings.Fields(rawRemote)
+		if len(remoteData) > 1 {
+			remoteURLs = append(remoteURLs, remoteData[1])
+		}
+	}
+
+	return remoteURLs, nil
+}
@@ -2,9 +2,12 @@ package git
 
 import (
 	"fmt"
+	"regexp"
 	"time"
 )
 
+var cherryPickRegex = regexp.MustCompile(`\(cherry picked from commit ([0-9a-f]{7,40})\)`)
+
 type Path string
 
 func (p Path) String() string {
@@ -29,7 +32,9 @@ type Commit struct {
 	Committer   Person
 	CommittedAt time.Time
 	IsMerge     bool
+	Parents     []string
 	Message     string
+	Body        string
 }
 
 func (c *Commit) String() string {
@@ -50,6 +55,23 @@ Date: %s
 		c.Message)
 }
 
+func (c *Commit) ShortID() string {
+	if len(c.ID) < 7 {
+		return c.ID
+	}
+
+	return c.ID[:7]
+}
+
+func (c *Commit) CherryPickedFrom() (string, bool) {
+	matches := cherryPickRegex.FindStringSubmatch(c.Body)
+	if len(matches) != 2 {
+		return "", false
+	}
+
+	return matches[1], true
+}
+
 func (c *Commit) Equal(other *Commit) bool {
 	return c.ID == other.ID &&
 		c.Author.Equal(other.Author) &&
@@ -57,7 +79,8 @@ func (c *Commit) Equal(other *Commit) bool {
 		c.Committer.Equal(other.Committer) &&
 		c.CommittedAt.Equal(other.CommittedAt) &&
 		c.IsMerge == other.IsMerge &&
-		c.Message == other.Message
+		c.Message == other.Message &&
+		c.Body == other.Body
 }
 
 type RemoteType int
@@ -76,5 +99,12 @@ type Remote struct {
 type Git interface {
 	Equal(other Git) bool
 	Log(from Reference, to Reference) ([]*Commit, error)
+	FindCommit(id string) (*Commit, error)
+	TagDate(tag Reference) (time.Time, error)
+	LatestTag(reference Reference) (Reference, error)
+	Tags(reference Reference) ([]Reference, error)
+	RecentCommits(count int) ([]*Commit, error)
+	DefaultBranch() (string, error)
+	Authors(reference Reference) ([]Person, error)
 	FindRemote() (*Remote, error)
 }
@@ -28,6 +28,23 @@ func FindRemoteFromURLs(remoteURLs []string) (*git.Remote, error) {
 	return remoteFromURL(remoteURL)
 }
 
+func RepositoryNamesFromURLs(remoteURLs []string) []string {
+	var names []string
+	seen := make(map[string]bool)
+
+	for _, remoteURL := range remoteURLs {
+		remote, err := remoteFromURL(remoteURL)
+		if err != nil || seen[strings.ToLower(remote.RepositoryName)] {
+			continue
+		}
+
+		seen[strings.ToLower(remote.RepositoryName)] = true
+		names = append(names, remote.RepositoryName)
+	}
+
+	return names
+}
+
 func remoteFromURL(url string) (*git.Remote, error) {
 	if strings.HasPrefix(url, "https://") {
 		return remoteFromHTTPSURL(url)
@@ -42,7 +59,7 @@ func remoteFromHTTPSURL(url string) (*git.Remote, error) {
 	matches := remoteHTTPSExtractor.FindStringSubmatch(url)
 
 	if len(matches) == 3 {
-		return &git.Remote{Type: git.HTTPS, Host: matches[1], RepositoryName: matches[2]}, nil
+		return &git.Remote{Type: git.HTTPS, Host: matches[1], RepositoryName: strings.TrimSuffix(matches[2], ".git")}, nil
 	}
 
 	return nil, fmt.Errorf("can't parse HTTPS remote: %s", url)
@@ -52,7 +69,7 @@ func remoteFromGitURL(url string) (*git.Remote, error) {
 	matches := remoteGitExtractor.FindStringSubmatch(url)
 
 	if len(matches) == 3 {
-		return &git.Remote{Type: git.GIT, Host: matches[1], RepositoryName: matches[2]}, nil
+		return &git.Remote{Type: git.GIT, Host: matches[1], RepositoryName: strings.TrimSuffix(matches[2], ".git")}, nil
 	}
 
 	return nil, fmt.Errorf("can't parse Git remote: %s", url)
@@ -30,6 +30,20 @@ func TestRemoteURLs(t *testing.T) {
 			"",
 			&git.Remote{Type: git.GIT, Host: "anurl.com", RepositoryName: "user/repo"},
 		},
+		{
+			"When has one GIT remote URL ending with .git",
+			[]string{"git@anurl.com:user/repo.git"},
+			true,
+			"",
+			&git.Remote{Type: git.GIT, Host: "anurl.com", RepositoryName: "user/repo"},
+		},
+		{
+			"When has one HTTPS remote URL ending with .git",
+			[]string{"https://anurl.com/user/repo.git"},
+			true,
+			"",
+			&git.Remote{Type: git.HTTPS, Host: "anurl.com", RepositoryName: "user/repo"},
+		},
 		{
 			"When has multiple different remote URLs",
 			[]string{"git@anurl.com:user/repo", "https://anotherurl.com/user/repo"},
@@ -93,3 +107,42 @@ func TestRemoteURLs(t *testing.T) {
 		})
 	}
 }
+
+func TestRepositoryNamesFromURLs(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		URLs     []string
+		Expected []string
+	}{
+		{
+			"When there is no remote",
+			nil,
+			nil,
+		},
+		{
+			"When remotes point to several repositories",
+			[]string{"git@anurl.com:user/repo", "https://anurl.com/user/repo", "https://anurl.com/upstream/repo"},
+			[]string{"user/repo", "upstream/repo"},
+		},
+		{
+			"When remotes end with .git",
+			[]string{"git@anurl.com:user/repo.git", "https://anurl.com/user/repo"},
+			[]string{"user/repo"},
+		},
+		{
+			"When a remote can't be parsed",
+			[]string{"ftp://anurl.com/user/other", "git@anurl.com:user/repo"},
+			[]string{"user/repo"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			names := utils.RepositoryNamesFromURLs(testCase.URLs)
+
+			if strings.Join(names, ",") != strings.Join(testCase.Expected, ",") {
+				t.Errorf("Wrong repository names. Expected: %v\nReceived: %v", testCase.Expected, names)
+			}
+		})
+	}
+}
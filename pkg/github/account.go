@@ -0,0 +1,82 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type TokenInfo struct {
+	Login     string
+	Scopes    []string
+	HasScopes bool
+}
+
+func (t TokenInfo) HasScope(scope string) bool {
+	for _, existing := range t.Scopes {
+		if existing == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g GitHub) CheckToken() (*TokenInfo, error) {
+	request, err := http.NewRequest("GET", fmt.Sprintf("%s/user", g.API_URL), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't create request to check the token")
+	}
+
+	response, body, err := g.do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't reach GitHub API at %s", g.API_URL)
+	}
+
+	if response.StatusCode == http.StatusUnauthorized {
+		return nil, fmt.Errorf("token is invalid: %s", string(body))
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("can't check the token: %s", string(body))
+	}
+
+	var user UserResponse
+
+	err = json.Unmarshal(body, &user)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't parse github user response")
+	}
+
+	info := &TokenInfo{Login: user.Login}
+
+	rawScopes, hasScopes := response.Header["X-Oauth-Scopes"]
+	if hasScopes {
+		info.HasScopes = true
+		for _, scope := range strings.Split(strings.Join(rawScopes, ","), ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				info.Scopes = append(info.Scopes, scope)
+			}
+		}
+	}
+
+	return info, nil
+}
+
+func (g GitHub) FindRepository(name string) (*RepositoryResponse, error) {
+	var repository RepositoryResponse
+
+	found, err := g.getJSON(fmt.Sprintf("%s/repos/%s", g.API_URL, name), &repository)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't fetch repository %s", name)
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	return &repository, nil
+}
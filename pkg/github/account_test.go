@@ -0,0 +1,129 @@
+package github_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kdisneur/changelog/pkg/github"
+	githubtest "github.com/kdisneur/changelog/pkg/testing/github"
+)
+
+func TestCheckToken(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		Token             string
+		Scopes            []string
+		IsValid           bool
+		ErrorMessage      string
+		ExpectedLogin     string
+		ExpectedHasScopes bool
+		ExpectedScopes    []string
+	}{
+		{"When token has scopes", ValidAPIToken, []string{"repo", "read:org"}, true, "", "kdisneur", true, []string{"repo", "read:org"}},
+		{"When token has no scopes", ValidAPIToken, []string{}, true, "", "kdisneur", true, nil},
+		{"When token doesn't report scopes", ValidAPIToken, nil, true, "", "kdisneur", false, nil},
+		{"When token is invalid", "invalid-token", nil, false, "token is invalid", "", false, nil},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			mock := githubtest.NewAccountMock(ValidAPIToken, "kdisneur")
+			mock.Scopes = testCase.Scopes
+			server := httptest.NewServer(http.HandlerFunc(mock.Handler))
+			defer server.Close()
+
+			githubTracker := github.GitHub{Token: testCase.Token, API_URL: server.URL, Repository: ValidRepositoryName}
+
+			info, err := githubTracker.CheckToken()
+
+			if err != nil && testCase.IsValid {
+				t.Fatalf("Expected no errors but got: %s", err.Error())
+			}
+
+			if err == nil && !testCase.IsValid {
+				t.Fatalf("Expected errors but got none: %+v", info)
+			}
+
+			if !testCase.IsValid {
+				if !strings.Contains(err.Error(), testCase.ErrorMessage) {
+					t.Fatalf("Wrong error. Expected: %s\nReceived: %s", testCase.ErrorMessage, err.Error())
+				}
+
+				return
+			}
+
+			if info.Login != testCase.ExpectedLogin || info.HasScopes != testCase.ExpectedHasScopes {
+				t.Fatalf("Wrong token info.\nExpected: %s (scopes: %t)\nReceived: %+v", testCase.ExpectedLogin, testCase.ExpectedHasScopes, info)
+			}
+
+			if strings.Join(info.Scopes, ",") != strings.Join(testCase.ExpectedScopes, ",") {
+				t.Fatalf("Wrong scopes.\nExpected: %v\nReceived: %v", testCase.ExpectedScopes, info.Scopes)
+			}
+		})
+	}
+}
+
+func TestFindRepository(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Token    string
+		Lookup   string
+		IsValid  bool
+		Expected *github.RepositoryResponse
+	}{
+		{"When repository is public", ValidAPIToken, "kdisneur/changelog", true, &github.RepositoryResponse{Name: "kdisneur/changelog", Private: false}},
+		{"When repository is private", ValidAPIToken, "kdisneur/secret", true, &github.RepositoryResponse{Name: "kdisneur/secret", Private: true}},
+		{"When repository doesn't exist", ValidAPIToken, "kdisneur/unknown", true, nil},
+		{"When token is invalid", "invalid-token", "kdisneur/changelog", false, nil},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			mock := githubtest.NewAccountMock(ValidAPIToken, "kdisneur")
+			mock.AddRepository("kdisneur/changelog", false)
+			mock.AddRepository("kdisneur/secret", true)
+			server := httptest.NewServer(http.HandlerFunc(mock.Handler))
+			defer server.Close()
+
+			githubTracker := github.GitHub{Token: testCase.Token, API_URL: server.URL, Repository: ValidRepositoryName}
+
+			repository, err := githubTracker.FindRepository(testCase.Lookup)
+
+			if err != nil && testCase.IsValid {
+				t.Fatalf("Expected no errors but got: %s", err.Error())
+			}
+
+			if err == nil && !testCase.IsValid {
+				t.Fatalf("Expected errors but got none: %+v", repository)
+			}
+
+			if !testCase.IsValid {
+				return
+			}
+
+			if (repository == nil) != (testCase.Expected == nil) || (repository != nil && *repository != *testCase.Expected) {
+				t.Fatalf("Wrong repository.\nExpected: %+v\nReceived: %+v", testCase.Expected, repository)
+			}
+		})
+	}
+}
+
+func TestFindRepositoryWithoutToken(t *testing.T) {
+	mock := githubtest.NewAccountMock("", "kdisneur")
+	mock.AddRepository("kdisneur/changelog", false)
+	server := httptest.NewServer(http.HandlerFunc(mock.Handler))
+	defer server.Close()
+
+	githubTracker := github.GitHub{API_URL: server.URL, Repository: ValidRepositoryName}
+
+	repository, err := githubTracker.FindRepository("kdisneur/changelog")
+	if err != nil {
+		t.Fatalf("Expected no errors but got: %s", err.Error())
+	}
+
+	if repository == nil || repository.Name != "kdisneur/changelog" {
+		t.Fatalf("Wrong repository. Received: %+v", repository)
+	}
+}
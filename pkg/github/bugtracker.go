@@ -11,12 +11,18 @@ import (
 	"github.com/pkg/errors"
 )
 
+const DefaultAPIURL = "https://api.github.com"
+
 func NewBugTracker(token string, repository string) bugtracker.BugTracker {
-	return NewBugTrackerWithAPI(token, "https://api.github.com", repository)
+	return NewBugTrackerWithAPI(token, DefaultAPIURL, repository)
 }
 
 func NewBugTrackerWithAPI(token string, apiURL string, repository string) bugtracker.BugTracker {
-	return GitHub{token, apiURL, repository}
+	return NewBugTrackerWithOptions(token, apiURL, repository, Options{})
+}
+
+func NewBugTrackerWithOptions(token string, apiURL string, repository string, options Options) bugtracker.BugTracker {
+	return GitHub{token, apiURL, repository, options}
 }
 
 func (g GitHub) Equal(other bugtracker.BugTracker) bool {
@@ -25,7 +31,7 @@ func (g GitHub) Equal(other bugtracker.BugTracker) bool {
 		return false
 	}
 
-	return g.Token == tracker.Token && g.API_URL == tracker.API_URL && g.Repository == tracker.Repository
+	return g.Token == tracker.Token && g.API_URL == tracker.API_URL && g.Repository == tracker.Repository && g.Options == tracker.Options
 }
 
 func (g GitHub) FindIssue(id string) (*bugtracker.Issue, error) {
@@ -36,7 +42,9 @@ func (g GitHub) FindIssue(id string) (*bugtracker.Issue, error) {
 		return nil, errors.Wrapf(err, "can't create request to fetch pull request %s", id)
 	}
 
-	request.Header.Add("Authorization", fmt.Sprintf("token %s", g.Token))
+	if g.Token != "" {
+		request.Header.Add("Authorization", fmt.Sprintf("token %s", g.Token))
+	}
 	request.Header.Add("Accept", "application/vnd.github.v3+json")
 
 	response, err := client.Do(request)
@@ -61,10 +69,30 @@ func (g GitHub) FindIssue(id string) (*bugtracker.Issue, error) {
 		return nil, errors.Wrapf(err, "can't parse github pull request %s response", id)
 	}
 
+	var labels []string
+	for _, label := range pullRequest.Labels {
+		labels = append(labels, label.Name)
+	}
+
+	var linkedIssues []bugtracker.LinkedIssue
+	if g.Options.LinkedIssues {
+		linkedIssues, err = findLinkedIssues(g, pullRequest)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &bugtracker.Issue{
 		ID:      strconv.Itoa(pullRequest.ID),
 		Subject: pullRequest.Subject,
 		Link:    pullRequest.Link,
+		Author: bugtracker.Author{
+			Login: pullRequest.User.Login,
+			Link:  pullRequest.User.Link,
+		},
+		Labels:       labels,
+		Body:         pullRequest.Body,
+		LinkedIssues: linkedIssues,
 	}, nil
 }
 
@@ -52,6 +52,12 @@ func TestBugTrackerFindIssue(t *testing.T) {
 				ID:      ValidPullRequestNumber,
 				Subject: ValidSubject,
 				Link:    fmt.Sprintf("https://github.com/%s/pulls/%s", ValidRepositoryName, ValidPullRequestNumber),
+				Author: bugtracker.Author{
+					Login: "johndoe",
+					Link:  "https://github.com/johndoe",
+				},
+				Labels: []string{"enhancement"},
+				Body:   githubtest.Body,
 			},
 		},
 		{
@@ -112,3 +118,125 @@ func TestBugTrackerFindIssue(t *testing.T) {
 		})
 	}
 }
+
+func TestBugTrackerFindIssueWithLinkedIssues(t *testing.T) {
+	validGithubPullRequestNumber, _ := strconv.Atoi(ValidPullRequestNumber)
+
+	testCases := []struct {
+		Name          string
+		Body          string
+		ClosingIssues []int
+		Expected      []bugtracker.LinkedIssue
+	}{
+		{
+			"When pull-request closes no issues",
+			"A long body describing the feature",
+			nil,
+			nil,
+		},
+		{
+			"When pull-request closes issues with keywords",
+			"This PR fixes #10.\n\nIt also closes kdisneur/website#3 and Resolves: #11",
+			nil,
+			[]bugtracker.LinkedIssue{
+				{ID: "10", Repository: "", Link: "https://github.com/kdisneur/changelog/issues/10"},
+				{ID: "3", Repository: "kdisneur/website", Link: "https://github.com/kdisneur/website/issues/3"},
+				{ID: "11", Repository: "", Link: "https://github.com/kdisneur/changelog/issues/11"},
+			},
+		},
+		{
+			"When pull-request closes issues with keywords and manual links",
+			"Fixes #10\nRelated to #12",
+			[]int{10, 14},
+			[]bugtracker.LinkedIssue{
+				{ID: "10", Repository: "", Link: "https://github.com/kdisneur/changelog/issues/10"},
+				{ID: "14", Repository: "", Link: "https://github.com/kdisneur/changelog/issues/14"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			mock := githubtest.NewMock(ValidAPIToken, ValidRepositoryName, 20181120, validGithubPullRequestNumber, ValidSubject)
+			mock.PullRequest.Body = testCase.Body
+			for _, number := range testCase.ClosingIssues {
+				mock.AddClosingIssue(ValidRepositoryName, number)
+			}
+
+			server := httptest.NewServer(http.HandlerFunc(mock.Handler))
+			defer server.Close()
+
+			githubTracker := github.NewBugTrackerWithOptions(ValidAPIToken, server.URL, ValidRepositoryName, github.Options{LinkedIssues: true})
+			actualIssue, err := githubTracker.FindIssue(ValidPullRequestNumber)
+
+			if err != nil {
+				t.Fatalf("Epexted no errors but got one. Received: %s", err.Error())
+			}
+
+			if len(actualIssue.LinkedIssues) != len(testCase.Expected) {
+				t.Fatalf("Wrong linked issues. Expected: %+v\nReceived: %+v", testCase.Expected, actualIssue.LinkedIssues)
+			}
+
+			for index, expected := range testCase.Expected {
+				if expected != actualIssue.LinkedIssues[index] {
+					t.Errorf("Wrong linked issue. Expected: %+v\nReceived: %+v", expected, actualIssue.LinkedIssues[index])
+				}
+			}
+		})
+	}
+}
+
+func TestBugTrackerFindIssueWithLinkedIssuesWhenGraphQLIsUnavailable(t *testing.T) {
+	validGithubPullRequestNumber, _ := strconv.Atoi(ValidPullRequestNumber)
+
+	testCases := []struct {
+		Name          string
+		Token         string
+		GraphQLToken  string
+		ExpectGraphQL bool
+	}{
+		{"When there is no token", "", "", false},
+		{"When the token is refused", ValidAPIToken, "wrong-token", true},
+		{"When the pull-request can't be resolved", ValidAPIToken, ValidAPIToken, true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			mock := githubtest.NewMock(testCase.Token, ValidRepositoryName, 20181120, validGithubPullRequestNumber, ValidSubject)
+			mock.PullRequest.Body = "Fixes #10"
+			mock.AddClosingIssue(ValidRepositoryName, 14)
+
+			calledGraphQL := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/graphql" {
+					calledGraphQL = true
+					r.Header.Set("Authorization", fmt.Sprintf("bearer %s", testCase.GraphQLToken))
+
+					if testCase.GraphQLToken == ValidAPIToken {
+						mock.PullRequest.Number++
+						defer func() { mock.PullRequest.Number-- }()
+					}
+				}
+
+				mock.Handler(w, r)
+			}))
+			defer server.Close()
+
+			githubTracker := github.NewBugTrackerWithOptions(testCase.Token, server.URL, ValidRepositoryName, github.Options{LinkedIssues: true})
+			actualIssue, err := githubTracker.FindIssue(ValidPullRequestNumber)
+
+			if err != nil {
+				t.Fatalf("Epexted no errors but got one. Received: %s", err.Error())
+			}
+
+			if calledGraphQL != testCase.ExpectGraphQL {
+				t.Errorf("Wrong GraphQL call. Expected: %t\nReceived: %t", testCase.ExpectGraphQL, calledGraphQL)
+			}
+
+			expected := []bugtracker.LinkedIssue{{ID: "10", Repository: "", Link: "https://github.com/kdisneur/changelog/issues/10"}}
+			if len(actualIssue.LinkedIssues) != 1 || actualIssue.LinkedIssues[0] != expected[0] {
+				t.Fatalf("Wrong linked issues. Expected: %+v\nReceived: %+v", expected, actualIssue.LinkedIssues)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kdisneur/changelog/pkg/bugtracker"
+	"github.com/pkg/errors"
+)
+
+var closingKeywordRegex = regexp.MustCompile("(?i)\\b(?:close[sd]?|fix(?:e[sd])?|resolve[sd]?):?\\s+([\\w.-]+/[\\w.-]+)?#([0-9]+)\\b")
+
+const closingIssuesQuery = `query($owner: String!, $name: String!, $number: Int!) {
+  repository(owner: $owner, name: $name) {
+    pullRequest(number: $number) {
+      closingIssuesReferences(first: 50) {
+        nodes {
+          number
+          url
+          repository { nameWithOwner }
+        }
+      }
+    }
+  }
+}`
+
+var errClosingIssuesUnavailable = errors.New("closing issues references are unavailable")
+
+func findLinkedIssues(github GitHub, pullRequest PullRequestResponse) ([]bugtracker.LinkedIssue, error) {
+	var closingReferences []bugtracker.LinkedIssue
+
+	if github.Token != "" {
+		var err error
+
+		closingReferences, err = fetchClosingIssuesReferences(github, pullRequest.ID)
+		if err != nil && err != errClosingIssuesUnavailable {
+			return nil, err
+		}
+	}
+
+	linkedIssues := append(closingReferences, parseClosingKeywords(github, pullRequest)...)
+
+	var uniqueLinkedIssues []bugtracker.LinkedIssue
+	seen := make(map[string]bool)
+	for _, linkedIssue := range linkedIssues {
+		key := strings.ToLower(fmt.Sprintf("%s#%s", linkedIssue.Repository, linkedIssue.ID))
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		uniqueLinkedIssues = append(uniqueLinkedIssues, linkedIssue)
+	}
+
+	return uniqueLinkedIssues, nil
+}
+
+func parseClosingKeywords(github GitHub, pullRequest PullRequestResponse) []bugtracker.LinkedIssue {
+	var linkedIssues []bugtracker.LinkedIssue
+
+	webURL := githubWebURL(github, pullRequest)
+	for _, matches := range closingKeywordRegex.FindAllStringSubmatch(pullRequest.Body, -1) {
+		repository := matches[1]
+		if repository == "" {
+			repository = github.Repository
+		}
+
+		linkedIssues = append(linkedIssues, bugtracker.LinkedIssue{
+			ID:         matches[2],
+			Repository: externalRepository(github, repository),
+			Link:       fmt.Sprintf("%s/%s/issues/%s", webURL, repository, matches[2]),
+		})
+	}
+
+	return linkedIssues
+}
+
+func fetchClosingIssuesReferences(github GitHub, number int) ([]bugtracker.LinkedIssue, error) {
+	repository := strings.SplitN(github.Repository, "/", 2)
+	if len(repository) != 2 {
+		return nil, fmt.Errorf("can't fetch linked issues of pull request %d: invalid repository name '%s'", number, github.Repository)
+	}
+
+	payload, err := json.Marshal(GraphQLRequest{
+		Query: closingIssuesQuery,
+		Variables: map[string]interface{}{
+			"owner":  repository[0],
+			"name":   repository[1],
+			"number": number,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't build linked issues request of pull request %d", number)
+	}
+
+	request, err := http.NewRequest("POST", githubGraphQLPath(github), bytes.NewReader(payload))
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't create request to fetch linked issues of pull request %d", number)
+	}
+
+	request.Header.Add("Authorization", fmt.Sprintf("bearer %s", github.Token))
+	request.Header.Add("Content-Type", "application/json")
+
+	response, err := (&http.Client{}).Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't fetch linked issues of pull request %d", number)
+	}
+
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't read github linked issues of pull request %d response", number)
+	}
+
+	if response.StatusCode == http.StatusUnauthorized {
+		return nil, errClosingIssuesUnavailable
+	}
+
+	if response.StatusCode != 200 {
+		return nil, fmt.Errorf("can't fetch linked issues of pull request %d: %s", number, string(body))
+	}
+
+	var closingIssues ClosingIssuesResponse
+
+	err = json.Unmarshal(body, &closingIssues)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't parse github linked issues of pull request %d response", number)
+	}
+
+	if len(closingIssues.Errors) > 0 {
+		return nil, errClosingIssuesUnavailable
+	}
+
+	var linkedIssues []bugtracker.LinkedIssue
+	for _, node := range closingIssues.Data.Repository.PullRequest.ClosingIssuesReferences.Nodes {
+		linkedIssues = append(linkedIssues, bugtracker.LinkedIssue{
+			ID:         strconv.Itoa(node.Number),
+			Repository: externalRepository(github, node.Repository.Name),
+			Link:       node.Link,
+		})
+	}
+
+	return linkedIssues, nil
+}
+
+func externalRepository(github GitHub, repository string) string {
+	if strings.EqualFold(github.Repository, repository) {
+		return ""
+	}
+
+	return repository
+}
+
+func githubGraphQLPath(github GitHub) string {
+	apiURL := strings.TrimSuffix(github.API_URL, "/")
+
+	if strings.HasSuffix(apiURL, "/api/v3") {
+		return fmt.Sprintf("%s/graphql", strings.TrimSuffix(apiURL, "/v3"))
+	}
+
+	return fmt.Sprintf("%s/graphql", apiURL)
+}
+
+func githubWebURL(github GitHub, pullRequest PullRequestResponse) string {
+	index := strings.Index(pullRequest.Link, fmt.Sprintf("/%s/", github.Repository))
+	if index < 0 {
+		return "https://github.com"
+	}
+
+	return pullRequest.Link[:index]
+}
@@ -26,6 +26,15 @@ func (m mergeParser) FindID(subject string) (string, error) {
 	}
 }
 
+func (m mergeParser) FindReferences(subject string) []parser.Reference {
+	id, err := m.FindID(subject)
+	if err != nil {
+		return nil
+	}
+
+	return []parser.Reference{{Type: parser.PullRequest, ID: id}}
+}
+
 func (m mergeParser) KeepCommit(subject string) bool {
 	return mergeRegex.MatchString(subject)
 }
@@ -122,3 +122,38 @@ func TestMergeParserKeepCommit(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeParserFindReferences(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		CommitMessage string
+		Expected      []parser.Reference
+	}{
+		{
+			"Commit message with a valid format",
+			"Merge pull request #1337 from kdisneur/changelog/#42-my_issue",
+			[]parser.Reference{{Type: parser.PullRequest, ID: "1337"}},
+		},
+		{
+			"Commit message with no merge message",
+			"Add a feature #1137 from kdisneur/changelog/#42-my_issue",
+			nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			actual := github.NewMergeParser().FindReferences(testCase.CommitMessage)
+
+			if len(actual) != len(testCase.Expected) {
+				t.Fatalf("Wrong references. Expected: %+v. Received: %+v", testCase.Expected, actual)
+			}
+
+			for index, expected := range testCase.Expected {
+				if expected != actual[index] {
+					t.Errorf("Wrong reference. Expected: %+v. Received: %+v", expected, actual[index])
+				}
+			}
+		})
+	}
+}
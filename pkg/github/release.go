@@ -0,0 +1,148 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+type ReleaseOptions struct {
+	Name            string
+	Draft           bool
+	Prerelease      bool
+	TargetCommitish string
+}
+
+func (g GitHub) PublishRelease(tag string, body string, options ReleaseOptions) (*ReleaseResponse, error) {
+	existingRelease, err := g.findRelease(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	name := options.Name
+	if name == "" {
+		name = tag
+	}
+
+	request := ReleaseRequest{
+		TagName:         tag,
+		TargetCommitish: options.TargetCommitish,
+		Name:            name,
+		Body:            body,
+		Draft:           options.Draft,
+		Prerelease:      options.Prerelease,
+	}
+
+	if existingRelease == nil {
+		return g.sendRelease("POST", fmt.Sprintf("%s/repos/%s/releases", g.API_URL, g.Repository), request, http.StatusCreated)
+	}
+
+	return g.sendRelease("PATCH", fmt.Sprintf("%s/repos/%s/releases/%d", g.API_URL, g.Repository, existingRelease.ID), request, http.StatusOK)
+}
+
+func (g GitHub) findRelease(tag string) (*ReleaseResponse, error) {
+	var release ReleaseResponse
+
+	found, err := g.getJSON(fmt.Sprintf("%s/repos/%s/releases/tags/%s", g.API_URL, g.Repository, tag), &release)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't fetch release %s", tag)
+	}
+
+	if found {
+		return &release, nil
+	}
+
+	var releases []ReleaseResponse
+
+	_, err = g.getJSON(fmt.Sprintf("%s/repos/%s/releases?per_page=100", g.API_URL, g.Repository), &releases)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't list releases")
+	}
+
+	for _, draft := range releases {
+		if draft.TagName == tag {
+			return &draft, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (g GitHub) getJSON(url string, value interface{}) (bool, error) {
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return false, err
+	}
+
+	response, body, err := g.do(request)
+	if err != nil {
+		return false, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return false, errors.New(string(body))
+	}
+
+	return true, json.Unmarshal(body, value)
+}
+
+func (g GitHub) sendRelease(method string, url string, release ReleaseRequest, expectedStatus int) (*ReleaseResponse, error) {
+	payload, err := json.Marshal(release)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't encode release %s", release.TagName)
+	}
+
+	request, err := http.NewRequest(method, url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't create request to publish release %s", release.TagName)
+	}
+	request.Header.Add("Content-Type", "application/json")
+
+	response, body, err := g.do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't publish release %s", release.TagName)
+	}
+
+	if response.StatusCode != expectedStatus {
+		return nil, fmt.Errorf("can't publish release %s: %s", release.TagName, string(body))
+	}
+
+	var published ReleaseResponse
+
+	err = json.Unmarshal(body, &published)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't parse github release %s response", release.TagName)
+	}
+
+	return &published, nil
+}
+
+func (g GitHub) do(request *http.Request) (*http.Response, []byte, error) {
+	client := &http.Client{}
+
+	if g.Token != "" {
+		request.Header.Add("Authorization", fmt.Sprintf("token %s", g.Token))
+	}
+	request.Header.Add("Accept", "application/vnd.github.v3+json")
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, body, nil
+}
@@ -0,0 +1,133 @@
+package github_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kdisneur/changelog/pkg/github"
+	githubtest "github.com/kdisneur/changelog/pkg/testing/github"
+)
+
+func TestPublishRelease(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Token            string
+		BuildMock        func() *githubtest.ReleasesMock
+		Options          github.ReleaseOptions
+		IsValid          bool
+		ErrorMessage     string
+		Expected         *github.ReleaseResponse
+		ExpectedReleases int
+	}{
+		{
+			Name:  "When the release doesn't exist",
+			Token: ValidAPIToken,
+			BuildMock: func() *githubtest.ReleasesMock {
+				return githubtest.NewReleasesMock(ValidAPIToken, ValidRepositoryName)
+			},
+			Options: github.ReleaseOptions{Prerelease: true, TargetCommitish: "main"},
+			IsValid: true,
+			Expected: &github.ReleaseResponse{
+				ID:              1,
+				TagName:         "v1.1.0",
+				TargetCommitish: "main",
+				Name:            "v1.1.0",
+				Body:            "- A nice feature",
+				Prerelease:      true,
+				Link:            "https://github.com/kdisneur/changelog/releases/tag/v1.1.0",
+			},
+			ExpectedReleases: 1,
+		},
+		{
+			Name:  "When the release already exists",
+			Token: ValidAPIToken,
+			BuildMock: func() *githubtest.ReleasesMock {
+				mock := githubtest.NewReleasesMock(ValidAPIToken, ValidRepositoryName)
+				mock.AddRelease(1, "v1.0.0", "v1.0.0", "- The first feature", false)
+				mock.AddRelease(2, "v1.1.0", "v1.1.0", "- An old description", false)
+
+				return mock
+			},
+			Options: github.ReleaseOptions{Name: "Version 1.1.0"},
+			IsValid: true,
+			Expected: &github.ReleaseResponse{
+				ID:              2,
+				TagName:         "v1.1.0",
+				TargetCommitish: "master",
+				Name:            "Version 1.1.0",
+				Body:            "- A nice feature",
+				Link:            "https://github.com/kdisneur/changelog/releases/tag/v1.1.0",
+			},
+			ExpectedReleases: 2,
+		},
+		{
+			Name:  "When the release exists as a draft",
+			Token: ValidAPIToken,
+			BuildMock: func() *githubtest.ReleasesMock {
+				mock := githubtest.NewReleasesMock(ValidAPIToken, ValidRepositoryName)
+				mock.AddRelease(1, "v1.1.0", "v1.1.0", "- An old description", true)
+
+				return mock
+			},
+			Options: github.ReleaseOptions{Draft: true},
+			IsValid: true,
+			Expected: &github.ReleaseResponse{
+				ID:              1,
+				TagName:         "v1.1.0",
+				TargetCommitish: "master",
+				Name:            "v1.1.0",
+				Body:            "- A nice feature",
+				Draft:           true,
+				Link:            "https://github.com/kdisneur/changelog/releases/tag/v1.1.0",
+			},
+			ExpectedReleases: 1,
+		},
+		{
+			Name:  "When token is invalid",
+			Token: "invalid-token",
+			BuildMock: func() *githubtest.ReleasesMock {
+				return githubtest.NewReleasesMock(ValidAPIToken, ValidRepositoryName)
+			},
+			IsValid:      false,
+			ErrorMessage: "Bad credentials",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			mock := testCase.BuildMock()
+			server := httptest.NewServer(http.HandlerFunc(mock.Handler))
+			defer server.Close()
+
+			githubTracker := github.GitHub{Token: testCase.Token, API_URL: server.URL, Repository: ValidRepositoryName}
+
+			release, err := githubTracker.PublishRelease("v1.1.0", "- A nice feature", testCase.Options)
+
+			if err != nil && testCase.IsValid {
+				t.Fatalf("Expected no errors but got: %s", err.Error())
+			}
+
+			if err == nil && !testCase.IsValid {
+				t.Fatalf("Expected errors but got none: %+v", release)
+			}
+
+			if !testCase.IsValid {
+				if !strings.Contains(err.Error(), testCase.ErrorMessage) {
+					t.Fatalf("Wrong error. Expected: %s\nReceived: %s", testCase.ErrorMessage, err.Error())
+				}
+
+				return
+			}
+
+			if *release != *testCase.Expected {
+				t.Fatalf("Wrong release.\nExpected: %+v\nReceived: %+v", testCase.Expected, release)
+			}
+
+			if len(mock.Releases) != testCase.ExpectedReleases {
+				t.Fatalf("Wrong number of releases. Expected: %d\nReceived: %d", testCase.ExpectedReleases, len(mock.Releases))
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 type squashParser struct{}
 
 var squashRegex = regexp.MustCompile("\\(#([0-9]+)\\)")
+var revertedTitleRegex = regexp.MustCompile(`^Revert ".*"`)
 
 func NewSquashParser() parser.Parser {
 	return squashParser{}
@@ -31,6 +32,17 @@ func (s squashParser) FindID(subject string) (string, error) {
 	return "", fmt.Errorf("can't parse commit subject '%s'", subject)
 }
 
+func (s squashParser) FindReferences(subject string) []parser.Reference {
+	var references []parser.Reference
+
+	ownSubject := revertedTitleRegex.ReplaceAllString(subject, "")
+	for _, matches := range squashRegex.FindAllStringSubmatch(ownSubject, -1) {
+		references = append(references, parser.Reference{Type: parser.PullRequest, ID: matches[1]})
+	}
+
+	return references
+}
+
 func (s squashParser) KeepCommit(subject string) bool {
 	return squashRegex.MatchString(subject)
 }
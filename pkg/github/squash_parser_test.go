@@ -110,3 +110,53 @@ func TestSquashParserKeepCommit(t *testing.T) {
 		})
 	}
 }
+
+func TestSquashParserFindReferences(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		CommitMessage string
+		Expected      []parser.Reference
+	}{
+		{
+			"Commit message with one reference",
+			"Add a nice feature (#1337)",
+			[]parser.Reference{{Type: parser.PullRequest, ID: "1337"}},
+		},
+		{
+			"Commit message with several references",
+			"Fix login (#12) (#15)",
+			[]parser.Reference{{Type: parser.PullRequest, ID: "12"}, {Type: parser.PullRequest, ID: "15"}},
+		},
+		{
+			"Commit message with no references",
+			"Add a nice feature #1337",
+			nil,
+		},
+		{
+			"Commit message reverting a pull request",
+			"Revert \"Fix login (#40)\" (#45)",
+			[]parser.Reference{{Type: parser.PullRequest, ID: "45"}},
+		},
+		{
+			"Commit message reverting a revert",
+			"Revert \"Revert \"Fix login (#40)\" (#45)\" (#46)",
+			[]parser.Reference{{Type: parser.PullRequest, ID: "46"}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			actual := github.NewSquashParser().FindReferences(testCase.CommitMessage)
+
+			if len(actual) != len(testCase.Expected) {
+				t.Fatalf("Wrong references. Expected: %+v. Received: %+v", testCase.Expected, actual)
+			}
+
+			for index, expected := range testCase.Expected {
+				if expected != actual[index] {
+					t.Errorf("Wrong reference. Expected: %+v. Received: %+v", expected, actual[index])
+				}
+			}
+		})
+	}
+}
@@ -4,10 +4,80 @@ type GitHub struct {
 	Token      string
 	API_URL    string
 	Repository string
+	Options    Options
+}
+
+type Options struct {
+	LinkedIssues bool
 }
 
 type PullRequestResponse struct {
-	ID      int    `json:"number"`
-	Subject string `json:"title"`
-	Link    string `json:"html_url"`
+	ID      int             `json:"number"`
+	Subject string          `json:"title"`
+	Link    string          `json:"html_url"`
+	User    UserResponse    `json:"user"`
+	Labels  []LabelResponse `json:"labels"`
+	Body    string          `json:"body"`
+}
+
+type UserResponse struct {
+	Login string `json:"login"`
+	Link  string `json:"html_url"`
+}
+
+type LabelResponse struct {
+	Name string `json:"name"`
+}
+
+type GraphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+type ClosingIssuesResponse struct {
+	Data struct {
+		Repository struct {
+			PullRequest struct {
+				ClosingIssuesReferences struct {
+					Nodes []ClosingIssueResponse `json:"nodes"`
+				} `json:"closingIssuesReferences"`
+			} `json:"pullRequest"`
+		} `json:"repository"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+type ClosingIssueResponse struct {
+	Number     int    `json:"number"`
+	Link       string `json:"url"`
+	Repository struct {
+		Name string `json:"nameWithOwner"`
+	} `json:"repository"`
+}
+
+type ReleaseRequest struct {
+	TagName         string `json:"tag_name"`
+	TargetCommitish string `json:"target_commitish,omitempty"`
+	Name            string `json:"name"`
+	Body            string `json:"body"`
+	Draft           bool   `json:"draft"`
+	Prerelease      bool   `json:"prerelease"`
+}
+
+type ReleaseResponse struct {
+	ID              int    `json:"id"`
+	TagName         string `json:"tag_name"`
+	TargetCommitish string `json:"target_commitish"`
+	Name            string `json:"name"`
+	Body            string `json:"body"`
+	Draft           bool   `json:"draft"`
+	Prerelease      bool   `json:"prerelease"`
+	Link            string `json:"html_url"`
+}
+
+type RepositoryResponse struct {
+	Name    string `json:"full_name"`
+	Private bool   `json:"private"`
 }
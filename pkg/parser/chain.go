@@ -0,0 +1,66 @@
+package parser
+
+import (
+	"fmt"
+)
+
+type chainParser struct {
+	parsers []Parser
+}
+
+func NewChainParser(parsers ...Parser) Parser {
+	return chainParser{parsers: parsers}
+}
+
+func (c chainParser) FindID(subject string) (string, error) {
+	for _, parser := range c.parsers {
+		if parser.KeepCommit(subject) {
+			return parser.FindID(subject)
+		}
+	}
+
+	return "", fmt.Errorf("can't parse commit subject '%s'", subject)
+}
+
+func (c chainParser) FindReferences(subject string) []Reference {
+	var references []Reference
+	seen := make(map[Reference]bool)
+
+	for _, parser := range c.parsers {
+		for _, reference := range parser.FindReferences(subject) {
+			if seen[reference] {
+				continue
+			}
+
+			seen[reference] = true
+			references = append(references, reference)
+		}
+	}
+
+	return references
+}
+
+func (c chainParser) KeepCommit(subject string) bool {
+	for _, parser := range c.parsers {
+		if parser.KeepCommit(subject) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c chainParser) Equal(other Parser) bool {
+	otherParser, hasGoodType := other.(chainParser)
+	if !hasGoodType || len(c.parsers) != len(otherParser.parsers) {
+		return false
+	}
+
+	for index, parser := range c.parsers {
+		if !parser.Equal(otherParser.parsers[index]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,139 @@
+package parser_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kdisneur/changelog/pkg/github"
+	"github.com/kdisneur/changelog/pkg/parser"
+)
+
+func newChainParser(t *testing.T) parser.Parser {
+	jiraParser, err := parser.NewTypedRegexParser("jira", "\\b(?P<id>[A-Z][A-Z0-9]+-[0-9]+)\\b", "", "", "jira", "https://jira.example.com/browse/{id}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return parser.NewChainParser(github.NewSquashParser(), github.NewMergeParser(), jiraParser)
+}
+
+func TestChainParserFindReferences(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		CommitMessage string
+		Expected      []parser.Reference
+	}{
+		{
+			"Commit message with a squash reference",
+			"Add a nice feature (#1337)",
+			[]parser.Reference{{Type: parser.PullRequest, ID: "1337"}},
+		},
+		{
+			"Commit message with a merge reference",
+			"Merge pull request #1337 from kdisneur/APP-42-my_issue",
+			[]parser.Reference{
+				{Type: parser.PullRequest, ID: "1337"},
+				{Type: "jira", ID: "APP-42", Link: "https://jira.example.com/browse/APP-42"},
+			},
+		},
+		{
+			"Commit message with several references of different types",
+			"APP-42 Fix login (#12) (#15)",
+			[]parser.Reference{
+				{Type: parser.PullRequest, ID: "12"},
+				{Type: parser.PullRequest, ID: "15"},
+				{Type: "jira", ID: "APP-42", Link: "https://jira.example.com/browse/APP-42"},
+			},
+		},
+		{
+			"Commit message with duplicated references",
+			"Merge pull request #12 from kdisneur/fix-login (#12)",
+			[]parser.Reference{{Type: parser.PullRequest, ID: "12"}},
+		},
+		{
+			"Commit message with no references",
+			"Add a nice feature",
+			nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			actual := newChainParser(t).FindReferences(testCase.CommitMessage)
+
+			if len(actual) != len(testCase.Expected) {
+				t.Fatalf("Wrong references. Expected: %+v. Received: %+v", testCase.Expected, actual)
+			}
+
+			for index, expected := range testCase.Expected {
+				if expected != actual[index] {
+					t.Errorf("Wrong reference. Expected: %+v. Received: %+v", expected, actual[index])
+				}
+			}
+		})
+	}
+}
+
+func TestChainParserFindID(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		CommitMessage string
+		IsValid       bool
+		ErrorMessage  string
+		Expected      string
+	}{
+		{
+			"Commit message kept by the first parser",
+			"APP-42 Add a nice feature (#1337)",
+			true,
+			"",
+			"1337",
+		},
+		{
+			"Commit message kept by the last parser",
+			"APP-42 Add a nice feature",
+			true,
+			"",
+			"APP-42",
+		},
+		{
+			"Commit message kept by no parsers",
+			"Add a nice feature",
+			false,
+			"can't parse commit subject",
+			"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			actual, err := newChainParser(t).FindID(testCase.CommitMessage)
+
+			if err != nil && testCase.IsValid {
+				t.Fatalf("Expected no errors but got one. Received: %s", err.Error())
+			}
+
+			if err == nil && !testCase.IsValid {
+				t.Fatalf("Expected an error but got none. Parsed ID '%s' from '%s'", actual, testCase.CommitMessage)
+			}
+
+			if err != nil && !strings.Contains(err.Error(), testCase.ErrorMessage) {
+				t.Fatalf("Wrong error. Expected: %s. Received: %s", testCase.ErrorMessage, err.Error())
+			}
+
+			if actual != testCase.Expected {
+				t.Fatalf("Wrong ID. Expected: %s. Received: %s", testCase.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestChainParserEqual(t *testing.T) {
+	if !newChainParser(t).Equal(newChainParser(t)) {
+		t.Errorf("Expected chain parsers with the same parsers to be equal")
+	}
+
+	if newChainParser(t).Equal(parser.NewChainParser(github.NewSquashParser())) {
+		t.Errorf("Expected chain parsers with different parsers to be different")
+	}
+}
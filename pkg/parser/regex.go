@@ -0,0 +1,153 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const idGroupName = "id"
+
+type regexParser struct {
+	name          string
+	regex         *regexp.Regexp
+	idIndex       int
+	keep          *regexp.Regexp
+	skip          *regexp.Regexp
+	referenceType string
+	link          string
+}
+
+func NewRegexParser(name string, regex string, keep string, skip string) (Parser, error) {
+	return NewTypedRegexParser(name, regex, keep, skip, PullRequest, "")
+}
+
+func NewTypedRegexParser(name string, regex string, keep string, skip string, referenceType string, link string) (Parser, error) {
+	idRegex, err := regexp.Compile(regex)
+	if err != nil {
+		return nil, fmt.Errorf("can't compile regex of parser '%s': %s", name, err.Error())
+	}
+
+	idIndex := subexpIndex(idRegex, idGroupName)
+	if idIndex < 0 {
+		return nil, fmt.Errorf("regex of parser '%s' must contain a named group '(?P<%s>...)'", name, idGroupName)
+	}
+
+	keepRegex, err := compileOptionalRegex(keep)
+	if err != nil {
+		return nil, fmt.Errorf("can't compile keep pattern of parser '%s': %s", name, err.Error())
+	}
+
+	skipRegex, err := compileOptionalRegex(skip)
+	if err != nil {
+		return nil, fmt.Errorf("can't compile skip pattern of parser '%s': %s", name, err.Error())
+	}
+
+	if referenceType == "" {
+		referenceType = PullRequest
+	}
+
+	if referenceType != PullRequest && link == "" {
+		return nil, fmt.Errorf("parser '%s' must define a link for its '%s' references", name, referenceType)
+	}
+
+	return regexParser{
+		name:          name,
+		regex:         idRegex,
+		idIndex:       idIndex,
+		keep:          keepRegex,
+		skip:          skipRegex,
+		referenceType: referenceType,
+		link:          link,
+	}, nil
+}
+
+func (r regexParser) FindID(subject string) (string, error) {
+	matches := r.regex.FindStringSubmatch(subject)
+
+	if matches == nil || matches[r.idIndex] == "" {
+		return "", fmt.Errorf("can't parse %s subject '%s'", r.name, subject)
+	}
+
+	return matches[r.idIndex], nil
+}
+
+func (r regexParser) FindReferences(subject string) []Reference {
+	if !r.KeepCommit(subject) {
+		return nil
+	}
+
+	var references []Reference
+	for _, matches := range r.regex.FindAllStringSubmatch(subject, -1) {
+		id := matches[r.idIndex]
+		if id == "" {
+			continue
+		}
+
+		references = append(references, Reference{Type: r.referenceType, ID: id, Link: r.referenceLink(id)})
+	}
+
+	return references
+}
+
+func (r regexParser) referenceLink(id string) string {
+	if r.link == "" {
+		return ""
+	}
+
+	return strings.Replace(r.link, "{id}", id, -1)
+}
+
+func (r regexParser) KeepCommit(subject string) bool {
+	if r.skip != nil && r.skip.MatchString(subject) {
+		return false
+	}
+
+	if r.keep != nil && !r.keep.MatchString(subject) {
+		return false
+	}
+
+	_, err := r.FindID(subject)
+
+	return err == nil
+}
+
+func (r regexParser) Equal(other Parser) bool {
+	otherParser, hasGoodType := other.(regexParser)
+	if !hasGoodType {
+		return false
+	}
+
+	return r.name == otherParser.name &&
+		r.regex.String() == otherParser.regex.String() &&
+		regexString(r.keep) == regexString(otherParser.keep) &&
+		regexString(r.skip) == regexString(otherParser.skip) &&
+		r.referenceType == otherParser.referenceType &&
+		r.link == otherParser.link
+}
+
+func subexpIndex(regex *regexp.Regexp, name string) int {
+	for index, subexpName := range regex.SubexpNames() {
+		if subexpName == name {
+			return index
+		}
+	}
+
+	return -1
+}
+
+func compileOptionalRegex(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+
+	return regexp.Compile(pattern)
+}
+
+func regexString(regex *regexp.Regexp) string {
+	if regex == nil {
+		return ""
+	}
+
+	return regex.String()
+}
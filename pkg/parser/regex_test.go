@@ -0,0 +1,321 @@
+package parser_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kdisneur/changelog/pkg/parser"
+)
+
+func TestNewRegexParser(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Regex        string
+		Keep         string
+		Skip         string
+		IsValid      bool
+		ErrorMessage string
+	}{
+		{
+			"When regex contains an id group",
+			"\\[#(?P<id>[0-9]+)\\]",
+			"",
+			"",
+			true,
+			"",
+		},
+		{
+			"When regex doesn't contain an id group",
+			"\\[#([0-9]+)\\]",
+			"",
+			"",
+			false,
+			"must contain a named group '(?P<id>...)'",
+		},
+		{
+			"When regex is invalid",
+			"\\[#(?P<id>[0-9]+\\]",
+			"",
+			"",
+			false,
+			"can't compile regex of parser 'bracket'",
+		},
+		{
+			"When keep pattern is invalid",
+			"\\[#(?P<id>[0-9]+)\\]",
+			"(feat",
+			"",
+			false,
+			"can't compile keep pattern of parser 'bracket'",
+		},
+		{
+			"When skip pattern is invalid",
+			"\\[#(?P<id>[0-9]+)\\]",
+			"",
+			"(chore",
+			false,
+			"can't compile skip pattern of parser 'bracket'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			regexParser, err := parser.NewRegexParser("bracket", testCase.Regex, testCase.Keep, testCase.Skip)
+
+			if err != nil && testCase.IsValid {
+				t.Fatalf("Expected no errors but got one. Received: %s", err.Error())
+			}
+
+			if err == nil && !testCase.IsValid {
+				t.Fatalf("Expected an error but got none. Received: %+v", regexParser)
+			}
+
+			if err != nil && !strings.Contains(err.Error(), testCase.ErrorMessage) {
+				t.Fatalf("Wrong error. Expected: %s. Received: %s", testCase.ErrorMessage, err.Error())
+			}
+		})
+	}
+}
+
+func TestRegexParserFindID(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Regex         string
+		CommitMessage string
+		IsValid       bool
+		ErrorMessage  string
+		Expected      string
+	}{
+		{
+			"Commit message with a bracket reference",
+			"\\[#(?P<id>[0-9]+)\\]",
+			"Add a nice feature [#1337]",
+			true,
+			"",
+			"1337",
+		},
+		{
+			"Commit message with a PR- reference",
+			"\\(PR-(?P<id>[0-9]+)\\)",
+			"Add a nice feature (PR-42)",
+			true,
+			"",
+			"42",
+		},
+		{
+			"Commit message with an invalid format",
+			"\\[#(?P<id>[0-9]+)\\]",
+			"Add a nice feature (#1337)",
+			false,
+			"can't parse custom subject",
+			"",
+		},
+		{
+			"Commit message with an empty id",
+			"\\[#(?P<id>[0-9]*)\\]",
+			"Add a nice feature [#]",
+			false,
+			"can't parse custom subject",
+			"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			regexParser, err := parser.NewRegexParser("custom", testCase.Regex, "", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actual, err := regexParser.FindID(testCase.CommitMessage)
+
+			if err != nil && testCase.IsValid {
+				t.Fatalf("Expected no errors but got one. Received: %s", err.Error())
+			}
+
+			if err == nil && !testCase.IsValid {
+				t.Fatalf("Expected an error but got none. Parsed ID '%s' from '%s'", actual, testCase.CommitMessage)
+			}
+
+			if err != nil && !strings.Contains(err.Error(), testCase.ErrorMessage) {
+				t.Fatalf("Wrong error. Expected: %s. Received: %s", testCase.ErrorMessage, err.Error())
+			}
+
+			if actual != testCase.Expected {
+				t.Fatalf("Wrong ID. Expected: %s. Received: %s", testCase.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestRegexParserKeepCommit(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Keep          string
+		Skip          string
+		CommitMessage string
+		IsValid       bool
+	}{
+		{
+			"Commit message with a valid format",
+			"",
+			"",
+			"Add a nice feature [#1337]",
+			true,
+		},
+		{
+			"Commit message with an invalid format",
+			"",
+			"",
+			"Add a nice feature #1337",
+			false,
+		},
+		{
+			"Commit message matching the keep pattern",
+			"^(feat|fix):",
+			"",
+			"feat: add a nice feature [#1337]",
+			true,
+		},
+		{
+			"Commit message not matching the keep pattern",
+			"^(feat|fix):",
+			"",
+			"chore: add a nice feature [#1337]",
+			false,
+		},
+		{
+			"Commit message matching the skip pattern",
+			"",
+			"^Bump ",
+			"Bump cobra to v0.0.4 [#1337]",
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			regexParser, err := parser.NewRegexParser("bracket", "\\[#(?P<id>[0-9]+)\\]", testCase.Keep, testCase.Skip)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actual := regexParser.KeepCommit(testCase.CommitMessage)
+
+			if testCase.IsValid && !actual {
+				t.Fatalf("Expected commit to be kept but is not: '%s'", testCase.CommitMessage)
+			}
+
+			if !testCase.IsValid && actual {
+				t.Fatalf("Expected commit to be rejected but is kept: '%s'", testCase.CommitMessage)
+			}
+		})
+	}
+}
+
+func TestNewTypedRegexParser(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Type         string
+		Link         string
+		IsValid      bool
+		ErrorMessage string
+	}{
+		{
+			"When type is a pull request",
+			"",
+			"",
+			true,
+			"",
+		},
+		{
+			"When type is not a pull request and defines a link",
+			"jira",
+			"https://jira.example.com/browse/{id}",
+			true,
+			"",
+		},
+		{
+			"When type is not a pull request and defines no link",
+			"jira",
+			"",
+			false,
+			"parser 'jira' must define a link for its 'jira' references",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			regexParser, err := parser.NewTypedRegexParser("jira", "(?P<id>[A-Z]+-[0-9]+)", "", "", testCase.Type, testCase.Link)
+
+			if err != nil && testCase.IsValid {
+				t.Fatalf("Expected no errors but got one. Received: %s", err.Error())
+			}
+
+			if err == nil && !testCase.IsValid {
+				t.Fatalf("Expected an error but got none. Received: %+v", regexParser)
+			}
+
+			if err != nil && !strings.Contains(err.Error(), testCase.ErrorMessage) {
+				t.Fatalf("Wrong error. Expected: %s. Received: %s", testCase.ErrorMessage, err.Error())
+			}
+		})
+	}
+}
+
+func TestRegexParserFindReferences(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Type          string
+		Link          string
+		Skip          string
+		CommitMessage string
+		Expected      []parser.Reference
+	}{
+		{
+			"Commit message with several pull request references",
+			"",
+			"",
+			"",
+			"Fix login [#12] [#15]",
+			[]parser.Reference{{Type: parser.PullRequest, ID: "12"}, {Type: parser.PullRequest, ID: "15"}},
+		},
+		{
+			"Commit message with typed references",
+			"jira",
+			"https://jira.example.com/browse/{id}",
+			"",
+			"Fix login [#APP-12]",
+			[]parser.Reference{{Type: "jira", ID: "APP-12", Link: "https://jira.example.com/browse/APP-12"}},
+		},
+		{
+			"Commit message matching the skip pattern",
+			"",
+			"",
+			"^Bump ",
+			"Bump cobra [#12]",
+			nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			regexParser, err := parser.NewTypedRegexParser("bracket", "\\[#(?P<id>[A-Z0-9-]+)\\]", "", testCase.Skip, testCase.Type, testCase.Link)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actual := regexParser.FindReferences(testCase.CommitMessage)
+
+			if len(actual) != len(testCase.Expected) {
+				t.Fatalf("Wrong references. Expected: %+v. Received: %+v", testCase.Expected, actual)
+			}
+
+			for index, expected := range testCase.Expected {
+				if expected != actual[index] {
+					t.Errorf("Wrong reference. Expected: %+v. Received: %+v", expected, actual[index])
+				}
+			}
+		})
+	}
+}
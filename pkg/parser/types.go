@@ -1,7 +1,16 @@
 package parser
 
+const PullRequest = "pull-request"
+
 type Parser interface {
 	FindID(subject string) (string, error)
+	FindReferences(subject string) []Reference
 	KeepCommit(subject string) bool
 	Equal(other Parser) bool
 }
+
+type Reference struct {
+	Type string
+	ID   string
+	Link string
+}
@@ -0,0 +1,48 @@
+package release
+
+import (
+	"time"
+
+	"github.com/kdisneur/changelog/pkg/bugtracker"
+	"github.com/kdisneur/changelog/pkg/git"
+)
+
+type Release struct {
+	VersionName   string
+	Date          time.Time
+	Unreleased    bool
+	CompareLink   string
+	Link          string
+	Repository    string
+	Issues        []*bugtracker.Issue
+	DirectCommits []*Commit
+	Reverted      []*bugtracker.Issue
+	Contributors  []*Contributor
+	Package       Package
+}
+
+type Package struct {
+	Name         string
+	Distribution string
+	Urgency      string
+	Maintainer   git.Person
+}
+
+type Commit struct {
+	Commit *git.Commit
+	Link   string
+}
+
+type Contributor struct {
+	Login     string
+	Name      string
+	Link      string
+	FirstTime bool
+}
+
+func (c *Contributor) Equal(other *Contributor) bool {
+	return c.Login == other.Login &&
+		c.Name == other.Name &&
+		c.Link == other.Link &&
+		c.FirstTime == other.FirstTime
+}
@@ -0,0 +1,130 @@
+package releasenote
+
+import (
+	"regexp"
+	"strings"
+)
+
+const DefaultHeading = "Release notes"
+const DefaultMarker = "release-note"
+const None = "NONE"
+
+var headingRegex = regexp.MustCompile("^(#{1,6})\\s+(.*?)\\s*#*\\s*$")
+var commentRegex = regexp.MustCompile("(?s)<!--.*?-->")
+
+type Extractor struct {
+	Heading string
+	Marker  string
+}
+
+func NewExtractor(heading string, marker string) *Extractor {
+	if heading == "" {
+		heading = DefaultHeading
+	}
+
+	if marker == "" {
+		marker = DefaultMarker
+	}
+
+	return &Extractor{Heading: heading, Marker: marker}
+}
+
+func (e *Extractor) Equal(other *Extractor) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+
+	return e.Heading == other.Heading && e.Marker == other.Marker
+}
+
+func (e *Extractor) Extract(body string) (string, bool) {
+	lines := strings.Split(commentRegex.ReplaceAllString(strings.Replace(body, "\r\n", "\n", -1), ""), "\n")
+
+	if note, ok := findFencedBlock(lines, e.Marker); ok {
+		return note, true
+	}
+
+	if note, ok := findHeadingSection(lines, e.Heading); ok {
+		return unwrapFencedBlock(note)
+	}
+
+	return "", false
+}
+
+func IsNone(note string) bool {
+	return strings.EqualFold(strings.TrimSpace(note), None)
+}
+
+func findFencedBlock(lines []string, marker string) (string, bool) {
+	var block []string
+	inBlock := false
+
+	for _, line := range lines {
+		trimmedLine := strings.TrimSpace(line)
+
+		if !inBlock && strings.HasPrefix(trimmedLine, "```") && strings.TrimSpace(strings.TrimPrefix(trimmedLine, "```")) == marker {
+			inBlock = true
+			continue
+		}
+
+		if inBlock && strings.HasPrefix(trimmedLine, "```") {
+			return cleanNote(block)
+		}
+
+		if inBlock {
+			block = append(block, line)
+		}
+	}
+
+	return "", false
+}
+
+func findHeadingSection(lines []string, heading string) (string, bool) {
+	var section []string
+	level := 0
+
+	for _, line := range lines {
+		matches := headingRegex.FindStringSubmatch(strings.TrimSpace(line))
+
+		if level == 0 {
+			if len(matches) == 3 && strings.EqualFold(matches[2], heading) {
+				level = len(matches[1])
+			}
+
+			continue
+		}
+
+		if len(matches) == 3 && len(matches[1]) <= level {
+			break
+		}
+
+		section = append(section, line)
+	}
+
+	if level == 0 {
+		return "", false
+	}
+
+	return cleanNote(section)
+}
+
+func unwrapFencedBlock(note string) (string, bool) {
+	lines := strings.Split(note, "\n")
+	if len(lines) < 2 || !strings.HasPrefix(lines[0], "```") || strings.TrimSpace(lines[len(lines)-1]) != "```" {
+		return note, true
+	}
+
+	for _, line := range lines[1 : len(lines)-1] {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			return note, true
+		}
+	}
+
+	return cleanNote(lines[1 : len(lines)-1])
+}
+
+func cleanNote(lines []string) (string, bool) {
+	note := strings.TrimSpace(strings.Join(lines, "\n"))
+
+	return note, note != ""
+}
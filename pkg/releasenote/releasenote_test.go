@@ -0,0 +1,134 @@
+package releasenote_test
+
+import (
+	"testing"
+
+	"github.com/kdisneur/changelog/pkg/releasenote"
+)
+
+func TestExtract(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Heading  string
+		Marker   string
+		Body     string
+		IsFound  bool
+		Expected string
+	}{
+		{
+			"When body has no release notes",
+			"",
+			"",
+			"A long body describing\nthe feature just added",
+			false,
+			"",
+		},
+		{
+			"When body has a release notes heading",
+			"",
+			"",
+			"## Description\n\nA developer summary\n\n### Release notes\n\nUsers can now log in with GitHub\n\n## Checklist\n\n- [x] Tests",
+			true,
+			"Users can now log in with GitHub",
+		},
+		{
+			"When the release notes heading is the last section",
+			"",
+			"",
+			"## Description\r\n\r\nA developer summary\r\n\r\n### Release Notes\r\n\r\nUsers can now log in\r\nwith GitHub\r\n",
+			true,
+			"Users can now log in\nwith GitHub",
+		},
+		{
+			"When the release notes heading contains a sub heading",
+			"",
+			"",
+			"## Release notes\n\nFirst line\n\n### Details\n\nSecond line\n\n## Checklist",
+			true,
+			"First line\n\n### Details\n\nSecond line",
+		},
+		{
+			"When the release notes heading is empty",
+			"",
+			"",
+			"### Release notes\n\n<!-- describe the change for the users -->\n\n### Checklist",
+			false,
+			"",
+		},
+		{
+			"When the release notes heading contains a fenced block",
+			"",
+			"",
+			"### Release notes\n\n```\nNONE\n```\n",
+			true,
+			"NONE",
+		},
+		{
+			"When body has a custom heading",
+			"User facing change",
+			"",
+			"### Release notes\n\nNot this one\n\n#### User facing change\n\nThis one",
+			true,
+			"This one",
+		},
+		{
+			"When body has a release-note fenced block",
+			"",
+			"",
+			"#### Does this PR introduce a user-facing change?\n\n```release-note\nAdd the `--contributors` flag\n```\n",
+			true,
+			"Add the `--contributors` flag",
+		},
+		{
+			"When body has a custom marker",
+			"",
+			"changelog",
+			"```release-note\nNot this one\n```\n\n```changelog\nThis one\n```",
+			true,
+			"This one",
+		},
+		{
+			"When the fenced block is never closed",
+			"",
+			"",
+			"```release-note\nAdd a feature",
+			false,
+			"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			extractor := releasenote.NewExtractor(testCase.Heading, testCase.Marker)
+			actual, found := extractor.Extract(testCase.Body)
+
+			if found != testCase.IsFound {
+				t.Fatalf("Wrong extraction. Expected found to be %t. Received: '%s'", testCase.IsFound, actual)
+			}
+
+			if actual != testCase.Expected {
+				t.Fatalf("Wrong release note.\nExpected:\n%s\nReceived:\n%s", testCase.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestIsNone(t *testing.T) {
+	testCases := []struct {
+		Note     string
+		Expected bool
+	}{
+		{"NONE", true},
+		{" none\n", true},
+		{"None of the users are impacted", false},
+		{"", false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Note, func(t *testing.T) {
+			if releasenote.IsNone(testCase.Note) != testCase.Expected {
+				t.Fatalf("Wrong result for '%s'. Expected: %t", testCase.Note, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/kdisneur/changelog/pkg/changelog"
+	"github.com/kdisneur/changelog/pkg/configuration"
+	"github.com/kdisneur/changelog/pkg/formatter"
+	sysutils "github.com/kdisneur/changelog/pkg/git/system/utils"
+)
+
+var changelogPathRegex = regexp.MustCompile(`^/repos/([A-Za-z0-9_.-]+)/([A-Za-z0-9_.-]+)/changelog$`)
+
+var contentTypes = map[string]string{
+	"atom":      "application/atom+xml; charset=utf-8",
+	"html":      "text/html; charset=utf-8",
+	"html-page": "text/html; charset=utf-8",
+	"json":      "application/json",
+	"markdown":  "text/markdown; charset=utf-8",
+	"slack":     "application/json",
+	"teams":     "application/json",
+}
+
+type FileLoader func(repositoryPath string) (configuration.File, error)
+
+type Server struct {
+	LoadFile         FileLoader
+	RepositoriesPath string
+}
+
+func New(loadFile FileLoader, repositoriesPath string) *Server {
+	return &Server{LoadFile: loadFile, RepositoriesPath: repositoriesPath}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	matches := changelogPathRegex.FindStringSubmatch(r.URL.Path)
+	if len(matches) != 3 || isRelativePath(matches[1]) || isRelativePath(matches[2]) {
+		http.NotFound(w, r)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	owner := matches[1]
+	repository := matches[2]
+
+	localPath := filepath.Join(s.RepositoriesPath, owner, repository)
+	if !sysutils.IsGitRepository(localPath) {
+		http.Error(w, fmt.Sprintf("unknown repository '%s/%s'", owner, repository), http.StatusNotFound)
+		return
+	}
+
+	query := r.URL.Query()
+
+	command := configuration.Command{
+		RepositoryName:      fmt.Sprintf("%s/%s", owner, repository),
+		RepositoryLocalPath: localPath,
+		From:                query.Get("from"),
+		To:                  query.Get("to"),
+		VersionName:         query.Get("version"),
+		Date:                time.Now(),
+		DateSource:          query.Get("date"),
+		Timezone:            query.Get("timezone"),
+	}
+
+	if command.From == "" || command.VersionName == "" {
+		http.Error(w, "'from' and 'version' parameters are mandatory", http.StatusBadRequest)
+		return
+	}
+
+	for _, reference := range []string{command.From, command.To} {
+		if strings.HasPrefix(reference, "-") {
+			http.Error(w, fmt.Sprintf("invalid reference '%s', it can't start with '-'", reference), http.StatusBadRequest)
+			return
+		}
+	}
+
+	format := query.Get("format")
+	if format == "" {
+		format = "markdown"
+	}
+
+	outputFormatter, err := formatter.New(format)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	file, err := s.LoadFile(localPath)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	conf, err := configuration.Validate(file, command)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	conf.Formatter = outputFormatter
+
+	result, err := changelog.Build(conf)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	contentType, ok := contentTypes[format]
+	if !ok {
+		contentType = "text/plain; charset=utf-8"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	fmt.Fprint(w, result.Changelog)
+}
+
+func isRelativePath(segment string) bool {
+	return segment == "." || segment == ".."
+}
@@ -0,0 +1,249 @@
+package server_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kdisneur/changelog/pkg/configuration"
+	"github.com/kdisneur/changelog/pkg/server"
+	githubtest "github.com/kdisneur/changelog/pkg/testing/github"
+	"github.com/kdisneur/changelog/pkg/testing/targz"
+)
+
+func TestServeHTTP(t *testing.T) {
+	repositoryPath, cleanup, err := targz.Untar("squash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	repositoriesPath := filepath.Dir(filepath.Dir(repositoryPath))
+	repositoryURL := "/repos/" + filepath.Base(filepath.Dir(repositoryPath)) + "/" + filepath.Base(repositoryPath) + "/changelog"
+
+	testCases := []struct {
+		Name           string
+		Method         string
+		URL            string
+		ExpectedStatus int
+		ExpectedBody   string
+	}{
+		{
+			Name:           "When the path is unknown",
+			Method:         http.MethodGet,
+			URL:            "/changelog",
+			ExpectedStatus: http.StatusNotFound,
+			ExpectedBody:   "404 page not found",
+		},
+		{
+			Name:           "When the path escapes the repositories folder",
+			Method:         http.MethodGet,
+			URL:            "/repos/../squash/changelog",
+			ExpectedStatus: http.StatusNotFound,
+			ExpectedBody:   "404 page not found",
+		},
+		{
+			Name:           "When the repository isn't cloned",
+			Method:         http.MethodGet,
+			URL:            "/repos/kdisneur/unknown/changelog?from=v1.0.0&version=v1.1.0",
+			ExpectedStatus: http.StatusNotFound,
+			ExpectedBody:   "unknown repository 'kdisneur/unknown'",
+		},
+		{
+			Name:           "When the method isn't GET",
+			Method:         http.MethodPost,
+			URL:            repositoryURL,
+			ExpectedStatus: http.StatusMethodNotAllowed,
+			ExpectedBody:   "method not allowed",
+		},
+		{
+			Name:           "When parameters are missing",
+			Method:         http.MethodGet,
+			URL:            repositoryURL + "?from=v1.0.0",
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedBody:   "'from' and 'version' parameters are mandatory",
+		},
+		{
+			Name:           "When the from reference is a git option",
+			Method:         http.MethodGet,
+			URL:            repositoryURL + "?from=--output=/tmp/changelog-injected&version=v1.1.0",
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedBody:   "invalid reference '--output=/tmp/changelog-injected', it can't start with '-'",
+		},
+		{
+			Name:           "When the to reference is a git option",
+			Method:         http.MethodGet,
+			URL:            repositoryURL + "?from=v1.0.0&to=--output=/tmp/changelog-injected&version=v1.1.0&date=commit",
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedBody:   "invalid reference '--output=/tmp/changelog-injected', it can't start with '-'",
+		},
+		{
+			Name:           "When the format is unknown",
+			Method:         http.MethodGet,
+			URL:            repositoryURL + "?from=v1.0.0&version=v1.1.0&format=xml",
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedBody:   "unknown format 'xml', expected one of: atom, debian, html, html-page, json, markdown, rpm, slack, teams",
+		},
+		{
+			Name:           "When the date is invalid",
+			Method:         http.MethodGet,
+			URL:            repositoryURL + "?from=v1.0.0&version=v1.1.0&date=tomorrow",
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedBody:   "can't parse date 'tomorrow', expected YYYY-MM-DD",
+		},
+		{
+			Name:           "When the range contains no commits",
+			Method:         http.MethodGet,
+			URL:            repositoryURL + "?from=master&to=v1.0.0&version=v1.1.0",
+			ExpectedStatus: http.StatusUnprocessableEntity,
+			ExpectedBody:   "no commits found",
+		},
+	}
+
+	handler := server.New(fileLoader(repositoryPath, configuration.File{}), repositoriesPath)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, httptest.NewRequest(testCase.Method, testCase.URL, nil))
+
+			if recorder.Code != testCase.ExpectedStatus {
+				t.Fatalf("Wrong status. Expected: %d\nReceived: %d (%s)", testCase.ExpectedStatus, recorder.Code, recorder.Body.String())
+			}
+
+			if !strings.Contains(recorder.Body.String(), testCase.ExpectedBody) {
+				t.Fatalf("Wrong body. Expected to contain: %s\nReceived: %s", testCase.ExpectedBody, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestServeHTTPWhenTheRepositoryConfigurationIsInvalid(t *testing.T) {
+	repositoryPath, cleanup, err := targz.Untar("squash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	repositoriesPath := filepath.Dir(filepath.Dir(repositoryPath))
+	repositoryURL := "/repos/" + filepath.Base(filepath.Dir(repositoryPath)) + "/" + filepath.Base(repositoryPath) + "/changelog"
+
+	handler := server.New(func(string) (configuration.File, error) {
+		return configuration.File{}, errors.New("invalid configuration file '.changelog.toml'")
+	}, repositoriesPath)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, repositoryURL+"?from=v1.0.0&version=v1.1.0", nil))
+
+	if recorder.Code != http.StatusInternalServerError {
+		t.Fatalf("Wrong status. Expected: %d\nReceived: %d (%s)", http.StatusInternalServerError, recorder.Code, recorder.Body.String())
+	}
+
+	if !strings.Contains(recorder.Body.String(), "invalid configuration file '.changelog.toml'") {
+		t.Errorf("Wrong body. Received: %s", recorder.Body.String())
+	}
+}
+
+func TestServeHTTPChangelog(t *testing.T) {
+	repositoryPath, cleanup, err := targz.Untar("squash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	owner := filepath.Base(filepath.Dir(repositoryPath))
+	repositoryName := owner + "/" + filepath.Base(repositoryPath)
+	repositoriesPath := filepath.Dir(filepath.Dir(repositoryPath))
+	repositoryURL := "/repos/" + repositoryName + "/changelog"
+
+	pullRequests := []githubtest.GitHubMock{
+		githubtest.NewMock("a-token", repositoryName, 1, 1337, "Adding feature 3"),
+		githubtest.NewMock("a-token", repositoryName, 2, 777, "Adding feature 4"),
+	}
+
+	github := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, pullRequest := range pullRequests {
+			pullRequestURL, _ := url.ParseRequestURI(pullRequest.PullRequest.URL)
+			if r.URL.Path == pullRequestURL.Path {
+				pullRequest.Handler(w, r)
+				return
+			}
+		}
+
+		http.NotFound(w, r)
+	}))
+	defer github.Close()
+
+	testCases := []struct {
+		Name                string
+		URL                 string
+		ExpectedContentType string
+		ExpectedBody        string
+	}{
+		{
+			Name:                "When the base branch is the default one",
+			URL:                 repositoryURL + "?from=v1.0.0&version=v1.1.0&date=2018-11-20&timezone=UTC",
+			ExpectedContentType: "text/markdown; charset=utf-8",
+			ExpectedBody: `## v1.1.0 - 2018-11-20
+
+- Adding feature 4 ([#777])
+- Adding feature 3 ([#1337])
+`,
+		},
+		{
+			Name:                "When the base branch is given",
+			URL:                 repositoryURL + "?from=v1.0.0&to=a2bc4fd&version=v1.1.0&date=2018-11-20&timezone=UTC&format=markdown",
+			ExpectedContentType: "text/markdown; charset=utf-8",
+			ExpectedBody: `## v1.1.0 - 2018-11-20
+
+- Adding feature 3 ([#1337])
+
+[#1337]: https://github.com/generated-data/squash/pulls/1337
+`,
+		},
+		{
+			Name:                "When the format is json",
+			URL:                 repositoryURL + "?from=v1.0.0&to=a2bc4fd&version=v1.1.0&date=2018-11-20&timezone=UTC&format=json",
+			ExpectedContentType: "application/json",
+			ExpectedBody:        `"subject": "Adding feature 3"`,
+		},
+	}
+
+	handler := server.New(fileLoader(repositoryPath, configuration.File{Github: configuration.GitHub{Token: "a-token", APIURL: github.URL}}), repositoriesPath)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, testCase.URL, nil))
+
+			if recorder.Code != http.StatusOK {
+				t.Fatalf("Wrong status. Expected: %d\nReceived: %d (%s)", http.StatusOK, recorder.Code, recorder.Body.String())
+			}
+
+			if contentType := recorder.Header().Get("Content-Type"); contentType != testCase.ExpectedContentType {
+				t.Fatalf("Wrong content type. Expected: %s\nReceived: %s", testCase.ExpectedContentType, contentType)
+			}
+
+			if !strings.Contains(recorder.Body.String(), testCase.ExpectedBody) {
+				t.Fatalf("Wrong body. Expected to contain:\n%s\nReceived:\n%s", testCase.ExpectedBody, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func fileLoader(expectedPath string, file configuration.File) server.FileLoader {
+	return func(repositoryPath string) (configuration.File, error) {
+		if repositoryPath != expectedPath {
+			return configuration.File{}, fmt.Errorf("unexpected repository path '%s'", repositoryPath)
+		}
+
+		return file, nil
+	}
+}
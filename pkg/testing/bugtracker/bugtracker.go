@@ -38,3 +38,24 @@ func (b *BugTracker) AddIssue(id string, subject string) {
 		Link:    fmt.Sprintf("https://bugtracker.com/issue/%s", id),
 	}
 }
+
+func (b *BugTracker) AddIssueWithAuthor(id string, subject string, login string) {
+	b.AddIssue(id, subject)
+
+	b.Issues[id].Author = bugtracker.Author{
+		Login: login,
+		Link:  fmt.Sprintf("https://bugtracker.com/user/%s", login),
+	}
+}
+
+func (b *BugTracker) AddIssueWithLabels(id string, subject string, labels ...string) {
+	b.AddIssue(id, subject)
+
+	b.Issues[id].Labels = labels
+}
+
+func (b *BugTracker) AddIssueWithBody(id string, subject string, body string) {
+	b.AddIssue(id, subject)
+
+	b.Issues[id].Body = body
+}
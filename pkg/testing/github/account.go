@@ -0,0 +1,54 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+type AccountMock struct {
+	Token        string
+	Login        string
+	Scopes       []string
+	Repositories map[string]bool
+}
+
+func NewAccountMock(token string, login string) *AccountMock {
+	return &AccountMock{Token: token, Login: login, Repositories: make(map[string]bool)}
+}
+
+func (m *AccountMock) AddRepository(name string, private bool) {
+	m.Repositories[name] = private
+}
+
+func (m *AccountMock) Handler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "token ")
+	if m.Token != token || r.Header.Get("Authorization") == "token " {
+		response, _ := json.Marshal(HTTPError{"Bad credentials", "https://developer.github.com"})
+		http.Error(w, string(response), 401)
+
+		return
+	}
+
+	if m.Scopes != nil {
+		w.Header().Set("X-OAuth-Scopes", strings.Join(m.Scopes, ", "))
+	}
+
+	if r.Method == "GET" && r.URL.Path == "/user" {
+		response, _ := json.Marshal(User{Login: m.Login, HTML_URL: "https://github.com/" + m.Login})
+		w.Write(response)
+		return
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, "/repos/")
+	if private, ok := m.Repositories[name]; r.Method == "GET" && ok {
+		response, _ := json.Marshal(Repository{FullName: name, Private: private})
+		w.Write(response)
+		return
+	}
+
+	response, _ := json.Marshal(HTTPError{"Not Found", "https://developer.github.com/v3/repos"})
+	http.Error(w, string(response), 404)
+}
@@ -8,7 +8,10 @@ import (
 	"strings"
 )
 
-const body string = `
+const author string = "johndoe"
+const label string = "enhancement"
+
+const Body string = `
 A long body describing
 the feature just added
 `
@@ -24,14 +27,33 @@ func NewMock(token string, repository string, id int, number int, title string)
 			HTML_URL: fmt.Sprintf("https://github.com/%s/pulls/%d", repository, number),
 			IssueURL: fmt.Sprintf("https://github.com/%s/issues/%d", repository, number),
 			Title:    title,
-			Body:     body,
+			Body:     Body,
+			User: User{
+				Login:    author,
+				HTML_URL: fmt.Sprintf("https://github.com/%s", author),
+			},
+			Labels: []Label{{Name: label}},
 		},
 	}
 }
 
+func (m *GitHubMock) AddClosingIssue(repository string, number int) {
+	m.ClosingIssues = append(m.ClosingIssues, ClosingIssue{
+		Number:     number,
+		URL:        fmt.Sprintf("https://github.com/%s/issues/%d", repository, number),
+		Repository: ClosingIssueRepository{NameWithOwner: repository},
+	})
+}
+
 func (m *GitHubMock) Handler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
+	if r.Method == "POST" && r.URL.Path == "/graphql" {
+		m.graphQLHandler(w, r)
+
+		return
+	}
+
 	pullRequestURL, err := url.ParseRequestURI(m.PullRequest.URL)
 	if err != nil || r.URL.Path != pullRequestURL.Path {
 		response, _ := json.Marshal(HTTPError{"Not Found", "https://developer.github.com/v3/pulls/#get-a-single-pull-request"})
@@ -51,3 +73,50 @@ func (m *GitHubMock) Handler(w http.ResponseWriter, r *http.Request) {
 	response, _ := json.Marshal(m.PullRequest)
 	w.Write(response)
 }
+
+func (m *GitHubMock) graphQLHandler(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "bearer ")
+	if m.Token != token {
+		response, _ := json.Marshal(HTTPError{"Bad credentials", "https://developer.github.com"})
+		http.Error(w, string(response), 401)
+
+		return
+	}
+
+	var request struct {
+		Variables struct {
+			Owner  string `json:"owner"`
+			Name   string `json:"name"`
+			Number int    `json:"number"`
+		} `json:"variables"`
+	}
+
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil || fmt.Sprintf("%s/%s", request.Variables.Owner, request.Variables.Name) != m.Repository || request.Variables.Number != m.PullRequest.Number {
+		response, _ := json.Marshal(map[string]interface{}{
+			"data":   nil,
+			"errors": []map[string]string{{"message": "Could not resolve to a PullRequest"}},
+		})
+		w.Write(response)
+
+		return
+	}
+
+	closingIssues := m.ClosingIssues
+	if closingIssues == nil {
+		closingIssues = []ClosingIssue{}
+	}
+
+	response, _ := json.Marshal(map[string]interface{}{
+		"data": map[string]interface{}{
+			"repository": map[string]interface{}{
+				"pullRequest": map[string]interface{}{
+					"closingIssuesReferences": map[string]interface{}{
+						"nodes": closingIssues,
+					},
+				},
+			},
+		},
+	})
+	w.Write(response)
+}
@@ -0,0 +1,89 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type ReleasesMock struct {
+	Token      string
+	Repository string
+	Releases   []*Release
+}
+
+func NewReleasesMock(token string, repository string) *ReleasesMock {
+	return &ReleasesMock{Token: token, Repository: repository}
+}
+
+func (m *ReleasesMock) AddRelease(id int, tag string, name string, body string, draft bool) {
+	m.Releases = append(m.Releases, &Release{
+		ID:              id,
+		TagName:         tag,
+		TargetCommitish: "master",
+		Name:            name,
+		Body:            body,
+		Draft:           draft,
+		HTML_URL:        fmt.Sprintf("https://github.com/%s/releases/tag/%s", m.Repository, tag),
+	})
+}
+
+func (m *ReleasesMock) Handler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "token ")
+	if m.Token != token {
+		response, _ := json.Marshal(HTTPError{"Bad credentials", "https://developer.github.com"})
+		http.Error(w, string(response), 401)
+
+		return
+	}
+
+	releasesPath := fmt.Sprintf("/repos/%s/releases", m.Repository)
+
+	switch {
+	case r.Method == "GET" && strings.HasPrefix(r.URL.Path, releasesPath+"/tags/"):
+		tag := strings.TrimPrefix(r.URL.Path, releasesPath+"/tags/")
+		for _, release := range m.Releases {
+			if release.TagName == tag && !release.Draft {
+				m.write(w, 200, release)
+				return
+			}
+		}
+	case r.Method == "GET" && r.URL.Path == releasesPath:
+		releases := m.Releases
+		if releases == nil {
+			releases = []*Release{}
+		}
+		m.write(w, 200, releases)
+		return
+	case r.Method == "POST" && r.URL.Path == releasesPath:
+		release := &Release{ID: len(m.Releases) + 1}
+		if json.NewDecoder(r.Body).Decode(release) != nil {
+			break
+		}
+		release.HTML_URL = fmt.Sprintf("https://github.com/%s/releases/tag/%s", m.Repository, release.TagName)
+		m.Releases = append(m.Releases, release)
+		m.write(w, 201, release)
+		return
+	case r.Method == "PATCH" && strings.HasPrefix(r.URL.Path, releasesPath+"/"):
+		id, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, releasesPath+"/"))
+		for _, release := range m.Releases {
+			if release.ID == id && json.NewDecoder(r.Body).Decode(release) == nil {
+				m.write(w, 200, release)
+				return
+			}
+		}
+	}
+
+	response, _ := json.Marshal(HTTPError{"Not Found", "https://developer.github.com/v3/repos/releases"})
+	http.Error(w, string(response), 404)
+}
+
+func (m *ReleasesMock) write(w http.ResponseWriter, status int, value interface{}) {
+	response, _ := json.Marshal(value)
+	w.WriteHeader(status)
+	w.Write(response)
+}
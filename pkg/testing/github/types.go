@@ -8,6 +8,17 @@ type PullRequest struct {
 	IssueURL string
 	Title    string
 	Body     string
+	User     User
+	Labels   []Label
+}
+
+type Label struct {
+	Name string
+}
+
+type User struct {
+	Login    string
+	HTML_URL string
 }
 
 type HTTPError struct {
@@ -16,7 +27,34 @@ type HTTPError struct {
 }
 
 type GitHubMock struct {
-	Token       string
-	Repository  string
-	PullRequest PullRequest
+	Token         string
+	Repository    string
+	PullRequest   PullRequest
+	ClosingIssues []ClosingIssue
+}
+
+type ClosingIssue struct {
+	Number     int                    `json:"number"`
+	URL        string                 `json:"url"`
+	Repository ClosingIssueRepository `json:"repository"`
+}
+
+type ClosingIssueRepository struct {
+	NameWithOwner string `json:"nameWithOwner"`
+}
+
+type Release struct {
+	ID              int    `json:"id"`
+	TagName         string `json:"tag_name"`
+	TargetCommitish string `json:"target_commitish"`
+	Name            string `json:"name"`
+	Body            string `json:"body"`
+	Draft           bool   `json:"draft"`
+	Prerelease      bool   `json:"prerelease"`
+	HTML_URL        string `json:"html_url"`
+}
+
+type Repository struct {
+	FullName string `json:"full_name"`
+	Private  bool   `json:"private"`
 }
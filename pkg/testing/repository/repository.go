@@ -1,18 +1,30 @@
 package repository
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/kdisneur/changelog/pkg/git"
 	"github.com/kdisneur/changelog/pkg/git/utils"
 	"time"
 )
 
 type Repository struct {
-	remoteURL string
-	Commits   []*git.Commit
+	remoteURL     string
+	defaultBranch string
+	Commits       []*git.Commit
+	Branches      map[string][]*git.Commit
+	tags          map[git.Reference]time.Time
 }
 
 func New(remoteURL string) *Repository {
-	return &Repository{remoteURL: remoteURL}
+	return &Repository{
+		remoteURL:     remoteURL,
+		defaultBranch: "master",
+		Branches:      make(map[string][]*git.Commit),
+		tags:          make(map[git.Reference]time.Time),
+	}
 }
 
 func (r Repository) Equal(other git.Git) bool {
@@ -25,8 +37,12 @@ func (r Repository) Equal(other git.Git) bool {
 }
 
 func (r Repository) Log(from git.Reference, to git.Reference) ([]*git.Commit, error) {
+	if branchCommits, ok := r.Branches[string(to)]; ok {
+		return branchCommits, nil
+	}
+
 	var commits []*git.Commit
-	shouldKeep := false
+	shouldKeep := from == ""
 
 	for _, commit := range r.Commits {
 		if shouldKeep {
@@ -46,6 +62,97 @@ func (r Repository) Log(from git.Reference, to git.Reference) ([]*git.Commit, er
 	return commits, nil
 }
 
+func (r Repository) FindCommit(id string) (*git.Commit, error) {
+	commits := append([]*git.Commit{}, r.Commits...)
+	for _, branchCommits := range r.Branches {
+		commits = append(commits, branchCommits...)
+	}
+
+	for _, commit := range commits {
+		if strings.HasPrefix(commit.ID, id) {
+			return commit, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown commit '%s'", id)
+}
+
+func (r Repository) TagDate(tag git.Reference) (time.Time, error) {
+	date, ok := r.tags[tag]
+	if !ok {
+		return time.Time{}, fmt.Errorf("unknown tag '%s'", tag)
+	}
+
+	return date, nil
+}
+
+func (r Repository) LatestTag(reference git.Reference) (git.Reference, error) {
+	var latest git.Reference
+	var latestDate time.Time
+
+	for tag, date := range r.tags {
+		if latest == "" || date.After(latestDate) {
+			latest = tag
+			latestDate = date
+		}
+	}
+
+	if latest == "" {
+		return "", fmt.Errorf("no tag reachable from '%s'", reference)
+	}
+
+	return latest, nil
+}
+
+func (r Repository) Tags(reference git.Reference) ([]git.Reference, error) {
+	var tags []git.Reference
+	for tag := range r.tags {
+		tags = append(tags, tag)
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		return r.tags[tags[i]].Before(r.tags[tags[j]])
+	})
+
+	return tags, nil
+}
+
+func (r *Repository) AddTag(tag git.Reference, date time.Time) {
+	r.tags[tag] = date
+}
+
+func (r Repository) RecentCommits(count int) ([]*git.Commit, error) {
+	var commits []*git.Commit
+
+	for index := len(r.Commits) - 1; index >= 0 && len(commits) < count; index-- {
+		commits = append(commits, r.Commits[index])
+	}
+
+	return commits, nil
+}
+
+func (r Repository) DefaultBranch() (string, error) {
+	return r.defaultBranch, nil
+}
+
+func (r *Repository) SetDefaultBranch(name string) {
+	r.defaultBranch = name
+}
+
+func (r Repository) Authors(reference git.Reference) ([]git.Person, error) {
+	var authors []git.Person
+
+	for _, commit := range r.Commits {
+		authors = append(authors, commit.Author)
+
+		if commit.ID == string(reference) {
+			return authors, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown reference '%s'", reference)
+}
+
 func (r Repository) FindRemote() (*git.Remote, error) {
 	return utils.FindRemoteFromURLs([]string{r.remoteURL})
 }
@@ -54,6 +161,27 @@ func (r *Repository) AddCommit(id string, author git.Person, authoredAt time.Tim
 	r.Commits = append(r.Commits, buildCommit(id, author, authoredAt, message, false))
 }
 
+func (r *Repository) AddCommitWithBody(id string, author git.Person, authoredAt time.Time, message string, body string) {
+	commit := buildCommit(id, author, authoredAt, message, false)
+	commit.Body = body
+
+	r.Commits = append(r.Commits, commit)
+}
+
+func (r *Repository) AddCommitWithParents(id string, author git.Person, authoredAt time.Time, message string, parents ...string) {
+	commit := buildCommit(id, author, authoredAt, message, len(parents) > 1)
+	commit.Parents = parents
+
+	r.Commits = append(r.Commits, commit)
+}
+
+func (r *Repository) AddBranchCommit(branch string, id string, author git.Person, authoredAt time.Time, message string, body string) {
+	commit := buildCommit(id, author, authoredAt, message, false)
+	commit.Body = body
+
+	r.Branches[branch] = append(r.Branches[branch], commit)
+}
+
 func (r *Repository) AddMergeCommit(id string, author git.Person, authoredAt time.Time, message string) {
 	r.Commits = append(r.Commits, buildCommit(id, author, authoredAt, message, true))
 }
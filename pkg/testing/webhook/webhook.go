@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	"io/ioutil"
+	"net/http"
+)
+
+type WebhookMock struct {
+	StatusCode  int
+	Response    string
+	ContentType string
+	Payloads    []string
+}
+
+func NewMock() *WebhookMock {
+	return &WebhookMock{StatusCode: 200, Response: "ok"}
+}
+
+func NewFailingMock(statusCode int, response string) *WebhookMock {
+	return &WebhookMock{StatusCode: statusCode, Response: response}
+}
+
+func (m *WebhookMock) Handler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "method_not_allowed", 405)
+
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "invalid_payload", 400)
+
+		return
+	}
+
+	m.ContentType = r.Header.Get("Content-Type")
+	m.Payloads = append(m.Payloads, string(body))
+
+	w.WriteHeader(m.StatusCode)
+	w.Write([]byte(m.Response))
+}
@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+func Post(url string, payload string) error {
+	if !json.Valid([]byte(payload)) {
+		return errors.New("can't post the changelog: the payload is not JSON")
+	}
+
+	request, err := http.NewRequest("POST", url, bytes.NewReader([]byte(payload)))
+	if err != nil {
+		return fmt.Errorf("can't post the changelog: %s", err.Error())
+	}
+	request.Header.Add("Content-Type", "application/json")
+
+	response, err := (&http.Client{}).Do(request)
+	if err != nil {
+		return fmt.Errorf("can't post the changelog: %s", err.Error())
+	}
+
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("can't post the changelog: %s", err.Error())
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("can't post the changelog: %s %s", response.Status, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package webhook_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	webhooktest "github.com/kdisneur/changelog/pkg/testing/webhook"
+	"github.com/kdisneur/changelog/pkg/webhook"
+)
+
+func TestPost(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Mock             *webhooktest.WebhookMock
+		Payload          string
+		IsValid          bool
+		ErrorMessage     string
+		ExpectedPayloads int
+	}{
+		{
+			Name:             "When the webhook accepts the payload",
+			Mock:             webhooktest.NewMock(),
+			Payload:          `{"text":"v1.0.0 - 2018-11-19"}`,
+			IsValid:          true,
+			ExpectedPayloads: 1,
+		},
+		{
+			Name:             "When the webhook rejects the payload",
+			Mock:             webhooktest.NewFailingMock(400, "invalid_blocks\n"),
+			Payload:          `{"text":"v1.0.0 - 2018-11-19"}`,
+			IsValid:          false,
+			ErrorMessage:     "can't post the changelog: 400 Bad Request invalid_blocks",
+			ExpectedPayloads: 1,
+		},
+		{
+			Name:             "When the payload is not JSON",
+			Mock:             webhooktest.NewMock(),
+			Payload:          "## v1.0.0 - 2018-11-19",
+			IsValid:          false,
+			ErrorMessage:     "can't post the changelog: the payload is not JSON",
+			ExpectedPayloads: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(testCase.Mock.Handler))
+			defer server.Close()
+
+			err := webhook.Post(server.URL, testCase.Payload)
+
+			if testCase.IsValid && err != nil {
+				t.Fatalf("Expected no error. Received: %s", err.Error())
+			}
+
+			if !testCase.IsValid && (err == nil || err.Error() != testCase.ErrorMessage) {
+				t.Fatalf("Wrong error. Expected: %s. Received: %v", testCase.ErrorMessage, err)
+			}
+
+			if len(testCase.Mock.Payloads) != testCase.ExpectedPayloads {
+				t.Fatalf("Wrong number of payloads. Expected: %d. Received: %d", testCase.ExpectedPayloads, len(testCase.Mock.Payloads))
+			}
+
+			if testCase.ExpectedPayloads > 0 {
+				if testCase.Mock.Payloads[0] != testCase.Payload {
+					t.Fatalf("Wrong payload. Expected: %s. Received: %s", testCase.Payload, testCase.Mock.Payloads[0])
+				}
+
+				if testCase.Mock.ContentType != "application/json" {
+					t.Fatalf("Wrong content type. Received: %s", testCase.Mock.ContentType)
+				}
+			}
+		})
+	}
+}